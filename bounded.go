@@ -0,0 +1,71 @@
+package testlogger
+
+import (
+	"bytes"
+	"log/slog"
+	"sync"
+
+	"github.com/onsi/gomega/gbytes"
+)
+
+// BoundedBuffer keeps only the most recent output written to it, discarding
+// older data once its size exceeds a limit. Whole records are discarded, so
+// the retained window never starts partway through a line.
+//
+// It is safe for concurrent use.
+type BoundedBuffer struct {
+	mu       sync.Mutex
+	data     []byte
+	maxBytes int
+}
+
+// Write appends p and then discards the oldest lines until the contents fit
+// within the limit. A record larger than the limit is discarded entirely.
+func (b *BoundedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.data = append(b.data, p...)
+	if excess := len(b.data) - b.maxBytes; excess > 0 {
+		cut := len(b.data)
+		if i := bytes.IndexByte(b.data[excess:], '\n'); i >= 0 {
+			cut = excess + i + 1
+		}
+		b.data = b.data[:copy(b.data, b.data[cut:])]
+	}
+	return len(p), nil
+}
+
+// Contents returns a copy of the retained output.
+func (b *BoundedBuffer) Contents() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Clone(b.data)
+}
+
+// Buffer returns a new gbytes.Buffer holding a copy of the retained output,
+// for use with gbytes.Say and the buffer assertions. Each call returns a
+// fresh buffer with its own read cursor. BoundedBuffer implements
+// gbytes.BufferProvider through this method.
+func (b *BoundedBuffer) Buffer() *gbytes.Buffer {
+	return gbytes.BufferWithBytes(b.Contents())
+}
+
+// WithCapturedLoggerBounded is like WithCapturedLogger but keeps only the
+// most recent maxBytes of output, preventing unbounded memory growth in
+// soak-style tests that log heavily.
+//
+// Older records are dropped as new ones arrive, so assertions only see the
+// recent window: do not assert on logs that may have been discarded.
+//
+// Usage:
+//
+//	logger, bounded := WithCapturedLoggerBounded(slog.LevelInfo, 64*1024)
+//	soak := NewSoakTest(logger)
+//	soak.Run(time.Hour)
+//	AssertNoErrorLogs(bounded.Buffer())
+//	Expect(bounded).To(gbytes.Say("shutdown complete"))
+func WithCapturedLoggerBounded(level slog.Level, maxBytes int) (*slog.Logger, *BoundedBuffer) {
+	bounded := &BoundedBuffer{maxBytes: maxBytes}
+	logger := slog.New(slog.NewTextHandler(bounded, &slog.HandlerOptions{Level: level}))
+	return logger, bounded
+}
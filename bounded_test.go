@@ -0,0 +1,54 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Bounded Capture", func() {
+	Describe("WithCapturedLoggerBounded", func() {
+		It("should keep only the most recent records", func() {
+			logger, bounded := testlogger.WithCapturedLoggerBounded(slog.LevelInfo, 200)
+
+			for i := 0; i < 100; i++ {
+				logger.Info("Soak iteration", "i", i)
+			}
+
+			contents := string(bounded.Contents())
+			Expect(len(contents)).To(BeNumerically("<=", 200))
+			Expect(contents).To(ContainSubstring("i=99\n"))
+			Expect(contents).NotTo(ContainSubstring("i=0\n"))
+			Expect(contents).To(HavePrefix("time="))
+		})
+
+		It("should work with the buffer assertions", func() {
+			logger, bounded := testlogger.WithCapturedLoggerBounded(slog.LevelInfo, 1024)
+
+			logger.Debug("Filtered out")
+			logger.Info("Shutdown complete")
+
+			Expect(bounded).To(gbytes.Say("Shutdown complete"))
+			Expect(bounded).To(gbytes.Say("Shutdown complete"))
+			testlogger.AssertNoErrorLogs(bounded.Buffer())
+			testlogger.AssertLogNotContains(bounded.Buffer(), "Filtered out")
+		})
+
+		It("should drop a record larger than the limit", func() {
+			logger, bounded := testlogger.WithCapturedLoggerBounded(slog.LevelInfo, 100)
+
+			logger.Info("Huge", "payload", strings.Repeat("x", 200))
+			Expect(bounded.Contents()).To(BeEmpty())
+			logger.Info("Small")
+
+			contents := string(bounded.Contents())
+			Expect(contents).NotTo(ContainSubstring("Huge"))
+			Expect(contents).To(ContainSubstring("msg=Small"))
+		})
+	})
+})
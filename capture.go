@@ -0,0 +1,126 @@
+package testlogger
+
+import (
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/onsi/gomega/gbytes"
+)
+
+// CaptureBuilder configures a captured logger through chained options,
+// consolidating the knobs offered by the WithCaptured* helpers into one
+// discoverable API. Create one with NewCapture and finish with Build.
+type CaptureBuilder struct {
+	level       slog.Level
+	json        bool
+	addSource   bool
+	stable      bool
+	precise     bool
+	tee         []io.Writer
+	replaceAttr func([]string, slog.Attr) slog.Attr
+	now         func() time.Time
+}
+
+// NewCapture starts building a captured text logger at slog.LevelInfo.
+//
+// Usage:
+//
+//	logger, buffer := NewCapture().
+//	    Level(slog.LevelDebug).
+//	    JSON().
+//	    AddSource().
+//	    Tee(os.Stderr).
+//	    Build()
+func NewCapture() *CaptureBuilder {
+	return &CaptureBuilder{level: slog.LevelInfo}
+}
+
+// Level sets the minimum level captured.
+func (b *CaptureBuilder) Level(level slog.Level) *CaptureBuilder {
+	b.level = level
+	return b
+}
+
+// JSON captures output in JSON format instead of text.
+func (b *CaptureBuilder) JSON() *CaptureBuilder {
+	b.json = true
+	return b
+}
+
+// AddSource includes the source file and line of each log call.
+func (b *CaptureBuilder) AddSource() *CaptureBuilder {
+	b.addSource = true
+	return b
+}
+
+// Stable makes output deterministic by omitting the time field from text
+// output and setting it to the zero time in JSON output.
+func (b *CaptureBuilder) Stable() *CaptureBuilder {
+	b.stable = true
+	return b
+}
+
+// PreciseTime records time with nanosecond precision instead of the
+// milliseconds used by text output, so records can be ordered reliably, for
+// example with AssertLogBefore. It has no effect when combined with Stable.
+func (b *CaptureBuilder) PreciseTime() *CaptureBuilder {
+	b.precise = true
+	return b
+}
+
+// Tee also writes captured output to w, such as os.Stderr for watching logs
+// live. It may be called more than once.
+func (b *CaptureBuilder) Tee(w io.Writer) *CaptureBuilder {
+	b.tee = append(b.tee, w)
+	return b
+}
+
+// ReplaceAttr installs a slog.HandlerOptions.ReplaceAttr function. When
+// combined with Stable or PreciseTime, time formatting is applied first.
+func (b *CaptureBuilder) ReplaceAttr(fn func([]string, slog.Attr) slog.Attr) *CaptureBuilder {
+	b.replaceAttr = fn
+	return b
+}
+
+// Clock stamps every record with the time returned by now instead of the
+// wall clock.
+func (b *CaptureBuilder) Clock(now func() time.Time) *CaptureBuilder {
+	b.now = now
+	return b
+}
+
+// Build creates the configured logger and the buffer capturing its output.
+func (b *CaptureBuilder) Build() (*slog.Logger, *gbytes.Buffer) {
+	buffer := gbytes.NewBuffer()
+	var writer io.Writer = buffer
+	if len(b.tee) > 0 {
+		writer = io.MultiWriter(append([]io.Writer{buffer}, b.tee...)...)
+	}
+
+	opts := &slog.HandlerOptions{
+		Level:       b.level,
+		AddSource:   b.addSource,
+		ReplaceAttr: b.replaceAttr,
+	}
+	if b.stable {
+		normalizeTime := removeTime
+		if b.json {
+			normalizeTime = zeroTime
+		}
+		opts.ReplaceAttr = chainReplaceAttr(normalizeTime, b.replaceAttr)
+	} else if b.precise {
+		opts.ReplaceAttr = chainReplaceAttr(preciseTime, b.replaceAttr)
+	}
+
+	var handler slog.Handler
+	if b.json {
+		handler = slog.NewJSONHandler(writer, opts)
+	} else {
+		handler = slog.NewTextHandler(writer, opts)
+	}
+	if b.now != nil {
+		handler = &clockHandler{Handler: handler, now: b.now}
+	}
+	return slog.New(handler), buffer
+}
@@ -0,0 +1,72 @@
+package testlogger_test
+
+import (
+	"bytes"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Capture Builder", func() {
+	Describe("NewCapture", func() {
+		It("should default to text output at INFO", func() {
+			logger, buffer := testlogger.NewCapture().Build()
+
+			logger.Debug("Filtered out")
+			logger.Info("Captured")
+
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("Filtered out"))
+			Expect(buffer).To(gbytes.Say("level=INFO msg=Captured"))
+		})
+
+		It("should combine JSON, source, tee and stable options", func() {
+			var tee bytes.Buffer
+			logger, buffer := testlogger.NewCapture().
+				Level(slog.LevelDebug).
+				JSON().
+				AddSource().
+				Stable().
+				Tee(&tee).
+				Build()
+
+			logger.Debug("Combined")
+
+			Expect(buffer).To(gbytes.Say(`\{"time":"0001-01-01T00:00:00Z","level":"DEBUG","source":\{.*"file":"\S+capture_test.go".*"msg":"Combined"`))
+			Expect(tee.String()).To(Equal(string(buffer.Contents())))
+		})
+
+		It("should apply a clock and a ReplaceAttr function", func() {
+			logger, buffer := testlogger.NewCapture().
+				Clock(func() time.Time { return time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }).
+				ReplaceAttr(func(_ []string, a slog.Attr) slog.Attr {
+					if a.Key == "password" {
+						return slog.String(a.Key, "hidden")
+					}
+					return a
+				}).
+				Build()
+
+			logger.Info("Login", "password", "hunter2")
+
+			Expect(string(buffer.Contents())).To(Equal(
+				"time=2025-01-01T00:00:00.000Z level=INFO msg=Login password=hidden\n"))
+		})
+
+		It("should record time with nanosecond precision", func() {
+			logger, buffer := testlogger.NewCapture().
+				Clock(func() time.Time { return time.Date(2025, 1, 1, 0, 0, 0, 123456789, time.UTC) }).
+				PreciseTime().
+				Build()
+
+			logger.Info("Precise")
+
+			Expect(string(buffer.Contents())).To(Equal(
+				"time=2025-01-01T00:00:00.123456789Z level=INFO msg=Precise\n"))
+		})
+	})
+})
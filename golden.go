@@ -0,0 +1,182 @@
+package testlogger
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+// updateGoldenEnvVar regenerates golden files when set to a true value, for
+// test binaries that do not define an -update flag.
+const updateGoldenEnvVar = "UPDATE_GOLDEN"
+
+var (
+	// goldenTextTime matches the time field of a text record.
+	goldenTextTime = regexp.MustCompile(`(?m)(^|\s)time=\S+`)
+	// goldenJSONTime matches the time field of a JSON record.
+	goldenJSONTime = regexp.MustCompile(`"time":"[^"]*"`)
+	// goldenTextSource matches the source field of a text record, capturing
+	// the file's base name.
+	goldenTextSource = regexp.MustCompile(`(^|\s)source="?(?:[^\s"]*/)?([^\s/"]+):\d+"?`)
+	// goldenJSONSource matches the source object of a JSON record.
+	goldenJSONSource = regexp.MustCompile(`"source":\{[^}]*\}`)
+	// goldenJSONFile and goldenJSONLine match fields inside a source object.
+	goldenJSONFile = regexp.MustCompile(`"file":"(?:[^"]*/)?([^"/]*)"`)
+	goldenJSONLine = regexp.MustCompile(`"line":\d+`)
+)
+
+// normalizeGolden replaces the volatile parts of captured output so it can
+// be compared across runs and machines: time fields become <time>, and
+// source locations are reduced to the file's base name with the line number
+// replaced by <line>.
+func normalizeGolden(contents string) string {
+	contents = goldenTextTime.ReplaceAllString(contents, "${1}time=<time>")
+	contents = goldenJSONTime.ReplaceAllString(contents, `"time":"<time>"`)
+	contents = goldenTextSource.ReplaceAllString(contents, "${1}source=${2}:<line>")
+	return goldenJSONSource.ReplaceAllStringFunc(contents, func(source string) string {
+		source = goldenJSONFile.ReplaceAllString(source, `"file":"${1}"`)
+		return goldenJSONLine.ReplaceAllString(source, `"line":"<line>"`)
+	})
+}
+
+// updateGolden reports whether golden files should be regenerated, either
+// because the test binary defines a boolean -update flag that is set or
+// because UPDATE_GOLDEN is true.
+func updateGolden() bool {
+	if f := flag.Lookup("update"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if update, ok := getter.Get().(bool); ok && update {
+				return true
+			}
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(updateGoldenEnvVar))) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// lineDiff returns a line-by-line diff turning want into got, prefixing
+// removed lines with "- ", added lines with "+ " and unchanged lines with
+// two spaces.
+func lineDiff(want, got string) string {
+	a := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			fmt.Fprintf(&diff, "  %s\n", a[i])
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			fmt.Fprintf(&diff, "+ %s\n", b[j])
+			j++
+		default:
+			fmt.Fprintf(&diff, "- %s\n", a[i])
+			i++
+		}
+	}
+	return diff.String()
+}
+
+// assertGolden compares normalized output against the golden file at path,
+// rewriting the file instead when updates are requested.
+func assertGolden(got string, goldenPath string) {
+	if updateGolden() {
+		err := os.MkdirAll(filepath.Dir(goldenPath), 0o755)
+		if err == nil {
+			err = os.WriteFile(goldenPath, []byte(got), 0o644)
+		}
+		Expect(err).NotTo(HaveOccurred(), "Failed to update golden file %s", goldenPath)
+		return
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		Expect(err).NotTo(HaveOccurred(),
+			"Golden file %s does not exist; run the tests with -update or %s=1 to create it", goldenPath, updateGoldenEnvVar)
+		return
+	}
+	if !Expect(err).NotTo(HaveOccurred(), "Failed to read golden file %s", goldenPath) {
+		return
+	}
+	Expect(got == string(want)).To(BeTrue(),
+		"Captured logs do not match golden file %s (run with -update or %s=1 to regenerate):\n--- golden\n+++ captured\n%s",
+		goldenPath, updateGoldenEnvVar, lineDiff(string(want), got))
+}
+
+// AssertLogMatchesGolden validates that the captured output matches the
+// golden file at goldenPath, reporting a line diff on mismatch.
+//
+// Volatile fields are normalized before comparison: time values become
+// <time>, and source locations are reduced to the file's base name with the
+// line number replaced by <line>. Records are compared in the order they
+// were logged; use AssertLogMatchesGoldenUnordered when goroutine
+// scheduling decides the order.
+//
+// When the test binary defines a boolean -update flag and it is set, or
+// UPDATE_GOLDEN=1 is in the environment, the golden file is written with
+// the normalized output instead of being compared. Define the flag in the
+// test package to follow the usual convention:
+//
+//	var _ = flag.Bool("update", false, "update golden files")
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	AssertLogMatchesGolden(buffer, "testdata/process_data.golden")
+func AssertLogMatchesGolden(buffer *gbytes.Buffer, goldenPath string) {
+	assertGolden(normalizeGolden(string(buffer.Contents())), goldenPath)
+}
+
+// AssertLogMatchesGoldenUnordered is like AssertLogMatchesGolden but sorts
+// the normalized records before comparison, so output from concurrent
+// goroutines matches regardless of scheduling. The golden file holds the
+// records in sorted order.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	pool := NewWorkerPool(logger, 4)
+//	pool.Run()
+//	AssertLogMatchesGoldenUnordered(buffer, "testdata/worker_pool.golden")
+func AssertLogMatchesGoldenUnordered(buffer *gbytes.Buffer, goldenPath string) {
+	records := splitRecords(normalizeGolden(string(buffer.Contents())))
+	slices.Sort(records)
+	got := strings.Join(records, "\n")
+	if got != "" {
+		got += "\n"
+	}
+	assertGolden(got, goldenPath)
+}
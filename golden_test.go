@@ -0,0 +1,111 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Golden Files", func() {
+	var goldenPath string
+
+	BeforeEach(func() {
+		goldenPath = filepath.Join(GinkgoT().TempDir(), "testdata", "logs.golden")
+	})
+
+	update := func() {
+		GinkgoT().Setenv("UPDATE_GOLDEN", "1")
+	}
+
+	Describe("AssertLogMatchesGolden", func() {
+		It("should write the golden file when updating", func() {
+			update()
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Processing started", "items", 3)
+			logger.Error("Processing failed", "item", 2)
+
+			testlogger.AssertLogMatchesGolden(buffer, goldenPath)
+
+			contents, err := os.ReadFile(goldenPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(
+				"time=<time> level=INFO msg=\"Processing started\" items=3\n" +
+					"time=<time> level=ERROR msg=\"Processing failed\" item=2\n"))
+		})
+
+		It("should normalize time and source locations", func() {
+			Expect(os.MkdirAll(filepath.Dir(goldenPath), 0o755)).To(Succeed())
+			Expect(os.WriteFile(goldenPath, []byte(
+				"time=<time> level=INFO source=golden_test.go:<line> msg=Done\n"), 0o644)).To(Succeed())
+			logger, buffer := testlogger.NewCapture().AddSource().Build()
+
+			logger.Info("Done")
+
+			testlogger.AssertLogMatchesGolden(buffer, goldenPath)
+		})
+
+		It("should normalize JSON time and source fields", func() {
+			update()
+			logger, buffer := testlogger.NewCapture().JSON().AddSource().Build()
+			logger.Info("Done")
+			testlogger.AssertLogMatchesGolden(buffer, goldenPath)
+
+			contents, err := os.ReadFile(goldenPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`"time":"<time>"`))
+			Expect(string(contents)).To(ContainSubstring(`"file":"golden_test.go","line":"<line>"`))
+		})
+
+		It("should report a line diff on mismatch", func() {
+			Expect(os.MkdirAll(filepath.Dir(goldenPath), 0o755)).To(Succeed())
+			Expect(os.WriteFile(goldenPath, []byte(
+				"time=<time> level=INFO msg=Started\ntime=<time> level=INFO msg=Finished\n"), 0o644)).To(Succeed())
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Started")
+			logger.Warn("Finished")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogMatchesGolden(buffer, goldenPath)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("  time=<time> level=INFO msg=Started\n"))
+			Expect(failures[0]).To(ContainSubstring("- time=<time> level=INFO msg=Finished\n"))
+			Expect(failures[0]).To(ContainSubstring("+ time=<time> level=WARN msg=Finished\n"))
+		})
+
+		It("should fail when the golden file is missing", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Started")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogMatchesGolden(buffer, goldenPath)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("does not exist"))
+		})
+	})
+
+	Describe("AssertLogMatchesGoldenUnordered", func() {
+		It("should match records regardless of order", func() {
+			update()
+			first, firstBuffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			first.Info("Worker done", "id", 1)
+			first.Info("Worker done", "id", 2)
+			testlogger.AssertLogMatchesGoldenUnordered(firstBuffer, goldenPath)
+
+			GinkgoT().Setenv("UPDATE_GOLDEN", "")
+			second, secondBuffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			second.Info("Worker done", "id", 2)
+			second.Info("Worker done", "id", 1)
+			testlogger.AssertLogMatchesGoldenUnordered(secondBuffer, goldenPath)
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package testlogger_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Logger Groups", func() {
+	Describe("LoggerGroup", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+		})
+
+		It("should capture each component to its own buffer", func() {
+			group := testlogger.NewLoggerGroup()
+
+			group.Logger("client").Error("Request sent")
+			group.Logger("server").Error("Request received")
+
+			Expect(group.Buffer("client")).To(gbytes.Say("Request sent"))
+			Expect(string(group.Buffer("client").Contents())).NotTo(ContainSubstring("Request received"))
+			Expect(group.Buffer("server")).To(gbytes.Say("Request received"))
+		})
+
+		It("should return the same logger for the same name", func() {
+			group := testlogger.NewLoggerGroup()
+
+			Expect(group.Logger("db")).To(BeIdenticalTo(group.Logger("db")))
+		})
+
+		It("should share the level from LOG_LEVEL", func() {
+			os.Setenv("LOG_LEVEL", "INFO")
+			group := testlogger.NewLoggerGroup()
+
+			group.Logger("client").Debug("Hidden detail")
+			group.Logger("server").Info("Visible message")
+
+			Expect(group.Buffer("client").Contents()).To(BeEmpty())
+			Expect(group.Buffer("server")).To(gbytes.Say("Visible message"))
+		})
+	})
+})
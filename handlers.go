@@ -0,0 +1,282 @@
+package testlogger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// multiHandler is a slog.Handler that forwards each record to every
+// underlying handler that is enabled for its level.
+type multiHandler []slog.Handler
+
+func (h multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h multiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, r.Level) {
+			errs = append(errs, handler.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(multiHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (h multiHandler) WithGroup(name string) slog.Handler {
+	handlers := make(multiHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return handlers
+}
+
+// contextHandler is a slog.Handler that adds attributes derived from the
+// record's context before delegating to the wrapped handler.
+type contextHandler struct {
+	slog.Handler
+	extract func(context.Context) []slog.Attr
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		r.AddAttrs(h.extract(ctx)...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs), extract: h.extract}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name), extract: h.extract}
+}
+
+// clockHandler is a slog.Handler that stamps each record with the time
+// reported by now instead of the wall clock.
+type clockHandler struct {
+	slog.Handler
+	now func() time.Time
+}
+
+func (h *clockHandler) Handle(ctx context.Context, r slog.Record) error {
+	r.Time = h.now()
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *clockHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &clockHandler{Handler: h.Handler.WithAttrs(attrs), now: h.now}
+}
+
+func (h *clockHandler) WithGroup(name string) slog.Handler {
+	return &clockHandler{Handler: h.Handler.WithGroup(name), now: h.now}
+}
+
+// failOnErrorHandler is a slog.Handler that fails the current test as soon
+// as a record at or above ERROR is handled, unless the formatted record
+// matches one of the allowed patterns, after delegating it to the wrapped
+// handler. ERROR records are checked even when the wrapped handler is not
+// enabled for them.
+//
+// Records are formatted for matching and failure messages by formatter, a
+// text handler that receives the same WithAttrs and WithGroup calls as the
+// wrapped handler, so attributes added with Logger.With are included.
+type failOnErrorHandler struct {
+	slog.Handler
+	allowed   []*regexp.Regexp
+	formatter slog.Handler
+	formatted *lockedBuffer
+}
+
+// lockedBuffer is the output of a failOnErrorHandler's formatter, shared by
+// the handlers derived from it.
+type lockedBuffer struct {
+	mu sync.Mutex
+	bytes.Buffer
+}
+
+func newFailOnErrorHandler(handler slog.Handler, allowed []*regexp.Regexp) *failOnErrorHandler {
+	formatted := &lockedBuffer{}
+	return &failOnErrorHandler{
+		Handler:   handler,
+		allowed:   allowed,
+		formatter: slog.NewTextHandler(formatted, &slog.HandlerOptions{ReplaceAttr: removeTime}),
+		formatted: formatted,
+	}
+}
+
+func (h *failOnErrorHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelError || h.Handler.Enabled(ctx, level)
+}
+
+func (h *failOnErrorHandler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	if h.Handler.Enabled(ctx, r.Level) {
+		err = h.Handler.Handle(ctx, r.Clone())
+	}
+	if r.Level >= slog.LevelError {
+		record := h.format(ctx, r)
+		if !matchesAny(record, h.allowed) {
+			fail(fmt.Sprintf("Unexpected %s log%s: %s", r.Level, recordSource(r), record))
+		}
+	}
+	return err
+}
+
+// format returns r in text format, including the handler's attributes and
+// groups.
+func (h *failOnErrorHandler) format(ctx context.Context, r slog.Record) string {
+	h.formatted.mu.Lock()
+	defer h.formatted.mu.Unlock()
+	h.formatted.Reset()
+	_ = h.formatter.Handle(ctx, r)
+	return strings.TrimSpace(h.formatted.String())
+}
+
+// fail reports message through Gomega's registered fail handler as the
+// whole failure, without the expected and actual values an assertion would
+// add. Going through Gomega rather than ginkgo.Fail keeps the failure
+// visible to InterceptGomegaFailures.
+func fail(message string) {
+	Expect(nil).To(failureMatcher(message))
+}
+
+// failureMatcher is a matcher that never matches and whose failure message
+// is the string itself.
+type failureMatcher string
+
+func (m failureMatcher) Match(any) (bool, error) { return false, nil }
+
+func (m failureMatcher) FailureMessage(any) string { return string(m) }
+
+func (m failureMatcher) NegatedFailureMessage(any) string { return string(m) }
+
+func (h *failOnErrorHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &failOnErrorHandler{
+		Handler:   h.Handler.WithAttrs(attrs),
+		allowed:   h.allowed,
+		formatter: h.formatter.WithAttrs(attrs),
+		formatted: h.formatted,
+	}
+}
+
+func (h *failOnErrorHandler) WithGroup(name string) slog.Handler {
+	return &failOnErrorHandler{
+		Handler:   h.Handler.WithGroup(name),
+		allowed:   h.allowed,
+		formatter: h.formatter.WithGroup(name),
+		formatted: h.formatted,
+	}
+}
+
+// goroutineHandler is a slog.Handler that adds a "goroutine" attribute
+// holding the ID of the goroutine that logged the record.
+type goroutineHandler struct {
+	slog.Handler
+}
+
+func (h *goroutineHandler) Handle(ctx context.Context, r slog.Record) error {
+	r.AddAttrs(slog.Uint64("goroutine", goroutineID()))
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *goroutineHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &goroutineHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *goroutineHandler) WithGroup(name string) slog.Handler {
+	return &goroutineHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// goroutineID returns the ID of the calling goroutine, parsed from the
+// "goroutine N [running]:" header of its stack trace. It returns 0 if the
+// header cannot be parsed.
+func goroutineID() uint64 {
+	var buf [64]byte
+	header := buf[:runtime.Stack(buf[:], false)]
+	header = bytes.TrimPrefix(header, []byte("goroutine "))
+	if i := bytes.IndexByte(header, ' '); i >= 0 {
+		header = header[:i]
+	}
+	id, err := strconv.ParseUint(string(header), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// countingHandler is a slog.Handler that atomically increments a counter
+// for each record it handles and writes nothing.
+type countingHandler struct {
+	count *int64
+	level slog.Leveler
+}
+
+func (h *countingHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *countingHandler) Handle(context.Context, slog.Record) error {
+	atomic.AddInt64(h.count, 1)
+	return nil
+}
+
+func (h *countingHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *countingHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+// recordSource describes where a record was logged, or returns an empty
+// string if its call site is unknown.
+func recordSource(r slog.Record) string {
+	if r.PC == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+	return fmt.Sprintf(" at %s:%d", frame.File, frame.Line)
+}
+
+// chainReplaceAttr combines ReplaceAttr functions, applying them in order
+// and stopping once an attribute has been removed. Nil functions are skipped.
+func chainReplaceAttr(fns ...func([]string, slog.Attr) slog.Attr) func([]string, slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		for _, fn := range fns {
+			if fn == nil {
+				continue
+			}
+			if a = fn(groups, a); a.Key == "" {
+				break
+			}
+		}
+		return a
+	}
+}
@@ -24,69 +24,433 @@ package testlogger
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"log/slog"
+	"maps"
+	"math"
 	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 )
 
-// getLogLevel reads the LOG_LEVEL environment variable and returns the appropriate slog.Level.
+// LevelTrace is a level below slog.LevelDebug for very verbose diagnostics,
+// enabled with LOG_LEVEL=TRACE.
+const LevelTrace = slog.Level(-8)
+
+// LevelOff is a level above every standard level (ERROR+4), used to suppress
+// all output including ERROR. It is selected with LOG_LEVEL=OFF or
+// LOG_LEVEL=SILENT.
+const LevelOff = slog.LevelError + 4
+
+// logLevelEnvVar is the environment variable read by getLogLevel.
+// It defaults to LOG_LEVEL and can be changed with ConfigureTestLoggingWithEnv.
+var logLevelEnvVar = "LOG_LEVEL"
+
+// defaultRedactedKeys are the attribute keys redacted by default.
+var defaultRedactedKeys = []string{"password", "token", "secret", "api_key", "authorization"}
+
+// redactedKeys holds the attribute keys whose values are redacted in logs
+// displayed by the ExpectErrorLog helpers.
+var redactedKeys = defaultRedactedKeys
+
+// redactedTextPattern and redactedJSONPattern match the values of
+// redactedKeys in text and JSON records. Both are nil when no keys are
+// redacted.
+var redactedTextPattern, redactedJSONPattern = compileRedactionPatterns(defaultRedactedKeys)
+
+// SetRedactedKeys replaces the set of attribute keys whose values are
+// replaced with "***" when the ExpectErrorLog helpers display captured logs,
+// as unexpected logs or in failure messages, so secrets don't leak into CI
+// output. Redaction affects only what is displayed: expected patterns are
+// matched against, and ExpectErrorLogCapture returns, the raw output.
+//
+// Keys are matched case-insensitively, including keys inside groups. The
+// default list is password, token, secret, api_key and authorization; to
+// extend it, include those keys alongside your own. Passing nil disables
+// redaction.
+//
+// Like the other package settings, call it from BeforeSuite rather than
+// concurrently with running specs:
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.SetRedactedKeys([]string{"password", "token", "ssn"})
+//	})
+func SetRedactedKeys(keys []string) {
+	redactedKeys = keys
+	redactedTextPattern, redactedJSONPattern = compileRedactionPatterns(keys)
+}
+
+// compileRedactionPatterns returns the patterns matching the values of keys
+// in text and JSON records, or nil patterns when keys is empty.
+func compileRedactionPatterns(keys []string) (textPattern, jsonPattern *regexp.Regexp) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = regexp.QuoteMeta(key)
+	}
+	alternatives := strings.Join(quoted, "|")
+	textPattern = regexp.MustCompile(`(?i)((?:^|\s)(?:[^\s=]*\.)?(?:` + alternatives + `)=)("(?:[^"\\]|\\.)*"|[^\s"]*)`)
+	jsonPattern = regexp.MustCompile(`(?i)("(?:` + alternatives + `)":)("(?:[^"\\]|\\.)*"|[^,{}\[\]"]+)`)
+	return textPattern, jsonPattern
+}
+
+// redactRecord replaces the values of redacted keys in a formatted text or
+// JSON record with "***", including keys inside groups. It is applied only
+// to records being displayed, so pattern matching and returned output still
+// see the raw logs.
+func redactRecord(record string) string {
+	if redactedTextPattern == nil {
+		return record
+	}
+	record = redactedTextPattern.ReplaceAllString(record, "${1}***")
+	return redactedJSONPattern.ReplaceAllString(record, `${1}"***"`)
+}
+
+// redactRecords returns a copy of records with each one redacted.
+func redactRecords(records []string) []string {
+	redacted := make([]string, len(records))
+	for i, record := range records {
+		redacted[i] = redactRecord(record)
+	}
+	return redacted
+}
+
+// isRedactedKey reports whether the final segment of a dotted attribute key
+// is one of the redacted keys.
+func isRedactedKey(key string) bool {
+	if i := strings.LastIndexByte(key, '.'); i >= 0 {
+		key = key[i+1:]
+	}
+	return slices.ContainsFunc(redactedKeys, func(redacted string) bool {
+		return strings.EqualFold(key, redacted)
+	})
+}
+
+// showUnexpectedLogs controls whether unexpected logs are displayed.
+var showUnexpectedLogs = true
+
+// SetShowUnexpectedLogs controls whether the ExpectErrorLog helpers display
+// logs that matched no expected pattern. Pattern validation is unaffected;
+// disabling it only silences the displayed output, which can become noise when
+// many specs run in parallel. The default is true.
+//
+// Like the other package settings, call it from BeforeSuite:
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.SetShowUnexpectedLogs(false)
+//	})
+func SetShowUnexpectedLogs(show bool) {
+	showUnexpectedLogs = show
+}
+
+// unexpectedLogWriter receives unexpected logs from the ExpectErrorLog helpers.
+var unexpectedLogWriter io.Writer = ginkgo.GinkgoWriter
+
+// SetUnexpectedLogWriter changes where the ExpectErrorLog helpers write logs
+// that matched no expected pattern. The default is ginkgo.GinkgoWriter, so
+// unexpected logs appear in the report of the spec that produced them, even
+// in parallel runs. Passing nil restores the default.
+//
+// Like the other package settings, call it from BeforeSuite:
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.SetUnexpectedLogWriter(os.Stderr)
+//	})
+func SetUnexpectedLogWriter(w io.Writer) {
+	if w == nil {
+		w = ginkgo.GinkgoWriter
+	}
+	unexpectedLogWriter = w
+}
+
+// failFast stops pattern validation at the first missing pattern.
+var failFast = false
+
+// SetFailFast controls whether the ExpectErrorLog helpers stop validating at
+// the first missing pattern. By default every missing pattern is listed in a
+// single failure, so several wrong expectations are found in one run.
+//
+// Like the other package settings, call it from BeforeSuite:
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.SetFailFast(true)
+//	})
+func SetFailFast(enabled bool) {
+	failFast = enabled
+}
+
+// colorOutput controls whether unexpected logs are colored by level.
+var colorOutput = false
+
+// ANSI escape sequences used to color unexpected logs.
+const (
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiReset  = "\x1b[0m"
+)
+
+// SetColorOutput controls whether the ExpectErrorLog helpers color
+// unexpected logs by level, red for ERROR and yellow for WARN, to make them
+// easier to scan. Color is only applied when the destination is a terminal,
+// so CI logs never contain escape sequences. The default is false.
+//
+// Like the other package settings, call it from BeforeSuite:
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.SetColorOutput(true)
+//	})
+func SetColorOutput(enabled bool) {
+	colorOutput = enabled
+}
+
+// isTerminal reports whether w writes to a terminal. GinkgoWriter is treated
+// as writing to stdout, where Ginkgo emits it.
+func isTerminal(w io.Writer) bool {
+	if w == ginkgo.GinkgoWriter {
+		w = os.Stdout
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize wraps each line of record in the color for its level, leaving
+// records below WARN or without a recognizable level unchanged.
+func colorize(record string) string {
+	level, ok := recordLevel(record)
+	if !ok || level < slog.LevelWarn {
+		return record
+	}
+	color := ansiYellow
+	if level >= slog.LevelError {
+		color = ansiRed
+	}
+	lines := strings.Split(record, "\n")
+	for i, line := range lines {
+		lines[i] = color + line + ansiReset
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getLogLevel reads the configured log level environment variable and returns the appropriate slog.Level.
+// Values are matched case-insensitively after trimming whitespace. Named levels take
+// precedence; otherwise numeric values such as "-8" or "8" are accepted as a fallback,
+// supporting custom levels below DEBUG.
 // Defaults to slog.Level(7) which is just below ERROR to suppress INFO and WARN.
 func getLogLevel() slog.Level {
+	value := strings.ToUpper(strings.TrimSpace(os.Getenv(logLevelEnvVar)))
 	if level, ok := map[string]slog.Level{
-		"DEBUG": slog.LevelDebug,
-		"INFO":  slog.LevelInfo,
-		"WARN":  slog.LevelWarn,
-		"ERROR": slog.LevelError,
-	}[os.Getenv("LOG_LEVEL")]; ok {
+		"TRACE":  LevelTrace,
+		"DEBUG":  slog.LevelDebug,
+		"INFO":   slog.LevelInfo,
+		"WARN":   slog.LevelWarn,
+		"ERROR":  slog.LevelError,
+		"OFF":    LevelOff,
+		"SILENT": LevelOff,
+	}[value]; ok {
 		return level
 	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return slog.Level(n)
+	}
 	return slog.Level(7) // Just below ERROR to suppress INFO and WARN
 }
 
+// errorCaptureLevel returns the level used to capture logs in the
+// ExpectErrorLog helpers: the LOG_LEVEL setting, but never above ERROR, so
+// expected errors are still captured when LOG_LEVEL is OFF.
+func errorCaptureLevel() slog.Level {
+	return min(getLogLevel(), slog.LevelError)
+}
+
 // expectErrorLogWithHandler is a helper that consolidates the common logic
 // for capturing and validating error logs with different handler types.
+// The captured logger emits records at or above level, and the complete
+// captured output is returned.
+//
+// Patterns must match in order, each after the end of the previous match,
+// mirroring a sequence of gbytes.Say assertions. Every missing pattern is
+// reported in a single failure unless SetFailFast is enabled, followed by
+// the full captured output for context.
 func expectErrorLogWithHandler(
 	handlerFactory func(io.Writer, *slog.HandlerOptions) slog.Handler,
+	level slog.Level,
 	testFunc func(*slog.Logger),
-	expectedPatterns ...string,
-) {
-	buffer := gbytes.NewBuffer()
-	var capturedOutput bytes.Buffer
-	writer := io.MultiWriter(buffer, &capturedOutput)
-	logger := slog.New(handlerFactory(writer, &slog.HandlerOptions{
-		Level: getLogLevel(),
-	}))
+	patterns []*regexp.Regexp,
+) string {
+	output := captureLogs(handlerFactory, &slog.HandlerOptions{Level: level}, testFunc)
+
+	// Validate expected patterns appear in the log output, collecting every
+	// missing pattern unless fail-fast is enabled
+	cursor := 0
+	var missing []string
+	for _, pattern := range patterns {
+		loc := pattern.FindStringIndex(output[cursor:])
+		if loc == nil {
+			missing = append(missing, pattern.String())
+			if failFast {
+				break
+			}
+			continue
+		}
+		cursor += loc[1]
+	}
+	if len(missing) == 1 {
+		Expect(missing).To(BeEmpty(), "Expected error log pattern not found: %s%s", missing[0], describeCaptured(output))
+	} else if len(missing) > 1 {
+		Expect(missing).To(BeEmpty(), "Expected error log patterns not found:\n  %s%s",
+			strings.Join(missing, "\n  "), describeCaptured(output))
+	}
+
+	// Display only unexpected logs (lines not matching any expected pattern)
+	printUnexpectedLogs(output, patterns)
+	return output
+}
+
+// describeCaptured formats captured output for a failure message, so a
+// missing pattern can be compared against what was actually logged.
+func describeCaptured(output string) string {
+	records := splitRecords(output)
+	if len(records) == 0 {
+		return "\nNo logs were captured"
+	}
+	return "\nCaptured logs:\n  " + strings.Join(redactRecords(records), "\n  ")
+}
+
+// captureLogs runs testFunc with a logger built by handlerFactory with the
+// given options and returns everything it logged.
+func captureLogs(
+	handlerFactory func(io.Writer, *slog.HandlerOptions) slog.Handler,
+	opts *slog.HandlerOptions,
+	testFunc func(*slog.Logger),
+) string {
+	var capturedOutput syncBuffer
+	logger := slog.New(handlerFactory(&capturedOutput, opts))
 
 	// Run test function with captured logger
 	testFunc(logger)
 
-	// Validate expected patterns appear in the log output
-	for _, pattern := range expectedPatterns {
-		Expect(buffer).To(gbytes.Say(pattern),
-			"Expected error log pattern not found: %s", pattern)
+	return capturedOutput.String()
+}
+
+// syncBuffer is a bytes.Buffer guarded by a mutex, so handlers that don't
+// serialize their own writes can safely log from many goroutines at once.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// textHandler is a handler factory producing slog.TextHandler instances.
+func textHandler(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+	return slog.NewTextHandler(w, opts)
+}
+
+// jsonHandler is a handler factory producing slog.JSONHandler instances.
+func jsonHandler(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+	return slog.NewJSONHandler(w, opts)
+}
+
+// compilePatterns compiles each expected pattern as a regular expression,
+// matching the semantics gbytes.Say applies when validating them.
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if !Expect(err).NotTo(HaveOccurred(), "Invalid log pattern: %s", pattern) {
+			// Fall back to a literal match so callers can continue safely
+			re = regexp.MustCompile(regexp.QuoteMeta(pattern))
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled
+}
+
+// firstMissingPattern returns the first pattern that does not match output
+// in order, each after the end of the previous match, or nil when all do.
+func firstMissingPattern(output string, patterns []*regexp.Regexp) *regexp.Regexp {
+	cursor := 0
+	for _, pattern := range patterns {
+		loc := pattern.FindStringIndex(output[cursor:])
+		if loc == nil {
+			return pattern
+		}
+		cursor += loc[1]
 	}
+	return nil
+}
 
-	// Display only unexpected logs (lines not matching any expected pattern)
-	lines := strings.Split(capturedOutput.String(), "\n")
-	for _, line := range lines {
-		if line == "" {
-			continue
+// matchesAny reports whether text matches at least one of the patterns.
+func matchesAny(text string, patterns []*regexp.Regexp) bool {
+	for _, re := range patterns {
+		if re.MatchString(text) {
+			return true
 		}
-		isExpected := false
-		for _, pattern := range expectedPatterns {
-			if strings.Contains(line, pattern) {
-				isExpected = true
-				break
-			}
+	}
+	return false
+}
+
+// unexpectedLogs returns each log record in output that matches none of the
+// expected patterns. Records rather than physical lines are compared, so a
+// multi-line message is kept or hidden as a single unit.
+func unexpectedLogs(output string, patterns []*regexp.Regexp) []string {
+	var unexpected []string
+	for _, record := range splitRecords(output) {
+		if matchesAny(record, patterns) {
+			continue
 		}
-		if !isExpected {
-			fmt.Fprintln(os.Stderr, line)
+		unexpected = append(unexpected, record)
+	}
+	return unexpected
+}
+
+// printUnexpectedLogs writes each record of output that matches none of the
+// expected patterns, so unexpected logs stay visible for debugging.
+func printUnexpectedLogs(output string, patterns []*regexp.Regexp) {
+	printLogs(unexpectedLogs(output, patterns))
+}
+
+// printLogs writes unexpected log records to the unexpected log writer.
+func printLogs(records []string) {
+	if !showUnexpectedLogs {
+		return
+	}
+	color := colorOutput && isTerminal(unexpectedLogWriter)
+	for _, record := range records {
+		record = redactRecord(record)
+		if color {
+			record = colorize(record)
 		}
+		fmt.Fprintln(unexpectedLogWriter, record)
 	}
 }
 
@@ -97,7 +461,8 @@ func expectErrorLogWithHandler(
 // clear test failures when expected log patterns are not found.
 //
 // Expected logs (matching validation patterns) are hidden from output.
-// Unexpected logs are displayed to stderr for debugging.
+// Unexpected logs are written to GinkgoWriter for debugging. Matching is done
+// per log record, so a multi-line message is hidden or shown as a whole.
 //
 // Usage:
 //
@@ -108,11 +473,251 @@ func expectErrorLogWithHandler(
 //	}, "rate limit exceeded", "status=429")
 func ExpectErrorLog(testFunc func(*slog.Logger), expectedPatterns ...string) {
 	expectErrorLogWithHandler(
-		func(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
-			return slog.NewTextHandler(w, opts)
+		textHandler,
+		errorCaptureLevel(),
+		testFunc,
+		compilePatterns(expectedPatterns),
+	)
+}
+
+// ExpectErrorLogReturns is like ExpectErrorLog but takes a test function
+// that returns an error, and also validates that the error is non-nil. This
+// makes the common "operation failed and logged about it" assertion a
+// one-liner.
+//
+// A nil error and a missing log pattern are reported as separate failures,
+// so it is clear which half of the expectation was not met.
+//
+// Usage:
+//
+//	ExpectErrorLogReturns(func(logger *slog.Logger) error {
+//	    client := NewClient(logger)
+//	    return client.CallAPI()
+//	}, "rate limit exceeded", "status=429")
+func ExpectErrorLogReturns(testFunc func(*slog.Logger) error, expectedPatterns ...string) {
+	var err error
+	expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		func(logger *slog.Logger) {
+			err = testFunc(logger)
 		},
+		compilePatterns(expectedPatterns),
+	)
+	Expect(err).To(HaveOccurred(), "Expected test function to return an error, but it returned nil")
+}
+
+// ExpectNoErrorLog runs a test function with a captured logger and validates
+// that it produced no ERROR level logs, the counterpart to ExpectErrorLog for
+// success paths. Any ERROR records are included in the failure message;
+// other captured logs are written to GinkgoWriter for debugging.
+//
+// Usage:
+//
+//	ExpectNoErrorLog(func(logger *slog.Logger) {
+//	    service := NewService(logger)
+//	    Expect(service.ProcessValidData()).To(Succeed())
+//	})
+func ExpectNoErrorLog(testFunc func(*slog.Logger)) {
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: errorCaptureLevel()}, testFunc)
+
+	var errorRecords, otherRecords []string
+	for _, record := range splitRecords(output) {
+		if level, ok := recordLevel(record); ok && level >= slog.LevelError {
+			errorRecords = append(errorRecords, record)
+		} else {
+			otherRecords = append(otherRecords, record)
+		}
+	}
+	Expect(errorRecords).To(BeEmpty(),
+		"Unexpected ERROR log found in output:\n%s", strings.Join(errorRecords, "\n"))
+
+	printLogs(otherRecords)
+}
+
+// ExpectErrorLogCapture is like ExpectErrorLog but returns the complete
+// captured output, allowing further custom assertions without re-running the
+// test function.
+//
+// Usage:
+//
+//	output := ExpectErrorLogCapture(func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallAPI()
+//	}, "rate limit exceeded")
+//	Expect(strings.Count(output, "\n")).To(Equal(2))
+func ExpectErrorLogCapture(testFunc func(*slog.Logger), expectedPatterns ...string) string {
+	return expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		testFunc,
+		compilePatterns(expectedPatterns),
+	)
+}
+
+// ExpectErrorLogRe is like ExpectErrorLog but accepts precompiled regular
+// expressions, avoiding recompilation in hot test loops and allowing reusable
+// package-level patterns with flags such as (?i). The same expressions decide
+// which logs are hidden as expected.
+//
+// Usage:
+//
+//	var rateLimited = regexp.MustCompile(`(?i)rate limit exceeded`)
+//
+//	ExpectErrorLogRe(func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    err := client.CallAPI()
+//	    Expect(err).To(HaveOccurred())
+//	}, rateLimited)
+func ExpectErrorLogRe(testFunc func(*slog.Logger), patterns ...*regexp.Regexp) {
+	expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		testFunc,
+		patterns,
+	)
+}
+
+// ExpectErrorLogLiteral is like ExpectErrorLog but treats each expected
+// string as a literal substring rather than a regular expression, so
+// brackets, parentheses and other metacharacters common in error messages
+// need no escaping. The same literals decide which logs are hidden.
+//
+// Usage:
+//
+//	ExpectErrorLogLiteral(func(logger *slog.Logger) {
+//	    db := NewDatabase(logger)
+//	    db.Connect()
+//	}, "[database] connection failed (attempt 3)")
+func ExpectErrorLogLiteral(testFunc func(*slog.Logger), substrings ...string) {
+	patterns := make([]*regexp.Regexp, len(substrings))
+	for i, substring := range substrings {
+		patterns[i] = regexp.MustCompile(regexp.QuoteMeta(substring))
+	}
+	expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		testFunc,
+		patterns,
+	)
+}
+
+// ExpectErrorLogWithHandler is like ExpectErrorLog but formats records with
+// a handler created by factory, so logs from custom handlers such as logfmt
+// variants or colorized handlers can be validated. The factory receives the
+// capture writer and the options, including the capture level, that the
+// handler should honor.
+//
+// Unexpected logs are identified per record when the handler emits a
+// text or JSON style level field, and per line otherwise.
+//
+// Usage:
+//
+//	ExpectErrorLogWithHandler(func(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+//	    return NewCompactHandler(w, opts)
+//	}, func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallAPI()
+//	}, "rate limit exceeded")
+func ExpectErrorLogWithHandler(
+	factory func(io.Writer, *slog.HandlerOptions) slog.Handler,
+	testFunc func(*slog.Logger),
+	expectedPatterns ...string,
+) {
+	expectErrorLogWithHandler(
+		factory,
+		errorCaptureLevel(),
 		testFunc,
-		expectedPatterns...,
+		compilePatterns(expectedPatterns),
+	)
+}
+
+// ExpectErrorLogEventually is like ExpectErrorLog but waits up to timeout
+// for the expected patterns to appear, for code that logs from background
+// goroutines after testFunc returns. Patterns must appear in order, and the
+// buffer is polled with Gomega's Eventually at its default interval. The
+// timeout is an overall deadline for every pattern, and the failure names the
+// first pattern still missing when it expires.
+//
+// The captured logger stays usable after testFunc returns, so late writes are
+// safe. Unexpected logs are those captured by the time every pattern has
+// matched or the timeout has elapsed; anything logged afterwards is ignored.
+//
+// Usage:
+//
+//	ExpectErrorLogEventually(func(logger *slog.Logger) {
+//	    worker := NewWorker(logger)
+//	    worker.Start() // logs asynchronously
+//	}, time.Second, "job failed", "retry scheduled")
+func ExpectErrorLogEventually(testFunc func(*slog.Logger), timeout time.Duration, expectedPatterns ...string) {
+	patterns := compilePatterns(expectedPatterns)
+	logger, buffer := NewCapture().Level(errorCaptureLevel()).Build()
+
+	testFunc(logger)
+
+	var missing *regexp.Regexp
+	Eventually(func() bool {
+		missing = firstMissingPattern(string(buffer.Contents()), patterns)
+		return missing == nil
+	}, timeout).Should(BeTrue(), func() string {
+		return fmt.Sprintf("Expected error log pattern not found within %s: %s", timeout, missing)
+	})
+
+	printUnexpectedLogs(string(buffer.Contents()), patterns)
+}
+
+// ExpectErrorLogUnder is like ExpectErrorLog but also validates that
+// testFunc completed in less than max wall-clock time, combining behavioral
+// and performance assertions for paths where a log marks a slow fallback.
+//
+// The duration covers testFunc only, not pattern validation. Wall-clock
+// timing varies between machines, so leave generous headroom in max to avoid
+// flaky failures on loaded CI runners.
+//
+// Usage:
+//
+//	ExpectErrorLogUnder(500*time.Millisecond, func(logger *slog.Logger) {
+//	    cache := NewCache(logger)
+//	    cache.Get("missing-key") // falls back to the database
+//	}, "cache miss", "loaded from database")
+func ExpectErrorLogUnder(max time.Duration, testFunc func(*slog.Logger), expectedPatterns ...string) {
+	var elapsed time.Duration
+	expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		func(logger *slog.Logger) {
+			start := time.Now()
+			testFunc(logger)
+			elapsed = time.Since(start)
+		},
+		compilePatterns(expectedPatterns),
+	)
+	Expect(elapsed).To(BeNumerically("<", max),
+		"Expected test function to complete within %s but it took %s", max, elapsed)
+}
+
+// ExpectErrorLogContext is like ExpectErrorLog but passes ctx to testFunc
+// alongside the captured logger, for code that logs through the *Context
+// methods such as Logger.ErrorContext and relies on context values.
+//
+// Usage:
+//
+//	ctx := context.WithValue(context.Background(), requestIDKey, "req-42")
+//	ExpectErrorLogContext(ctx, func(ctx context.Context, logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    err := client.CallAPI(ctx)
+//	    Expect(err).To(HaveOccurred())
+//	}, "rate limit exceeded")
+func ExpectErrorLogContext(
+	ctx context.Context,
+	testFunc func(context.Context, *slog.Logger),
+	expectedPatterns ...string,
+) {
+	expectErrorLogWithHandler(
+		textHandler,
+		errorCaptureLevel(),
+		func(logger *slog.Logger) { testFunc(ctx, logger) },
+		compilePatterns(expectedPatterns),
 	)
 }
 
@@ -123,7 +728,7 @@ func ExpectErrorLog(testFunc func(*slog.Logger), expectedPatterns ...string) {
 // clear test failures when expected log patterns are not found.
 //
 // Expected logs (matching validation patterns) are hidden from output.
-// Unexpected logs are displayed to stderr for debugging.
+// Unexpected logs are written to GinkgoWriter for debugging.
 //
 // Usage:
 //
@@ -133,58 +738,946 @@ func ExpectErrorLog(testFunc func(*slog.Logger), expectedPatterns ...string) {
 //	}, `"level":"ERROR"`, `"msg":"validation failed"`, `"field":"email"`)
 func ExpectErrorLogJSON(testFunc func(*slog.Logger), expectedPatterns ...string) {
 	expectErrorLogWithHandler(
-		func(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
-			return slog.NewJSONHandler(w, opts)
-		},
+		jsonHandler,
+		errorCaptureLevel(),
 		testFunc,
-		expectedPatterns...,
+		compilePatterns(expectedPatterns),
 	)
 }
 
-// WithCapturedLogger creates a logger that writes to a gbytes.Buffer,
-// allowing manual validation of log output using Gomega matchers.
+// ExpectErrorLogJSONObject captures JSON logs and validates that one record
+// exactly equals expected once its time field is dropped. Unlike substring
+// patterns, this fails when a record carries extra or missing fields.
 //
-// This provides fine-grained control over log validation when the automatic
-// pattern matching in ExpectErrorLog is not sufficient.
+// Expected values are compared after a JSON round trip, so Go ints match
+// JSON numbers and nested maps match slog.Group attributes. Records that do
+// not equal expected are written to GinkgoWriter for debugging.
+//
+// A failure reports the nearest record with a key-by-key diff listing
+// missing keys, wrong values and extra keys.
 //
 // Usage:
 //
-//	logger, buffer := WithCapturedLogger(slog.LevelError)
-//	service := NewService(logger)
-//	service.ProcessData()
-//	Expect(buffer).To(gbytes.Say("processing started"))
-func WithCapturedLogger(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
-	buffer := gbytes.NewBuffer()
-	logger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
-		Level: level,
-	}))
-	return logger, buffer
+//	ExpectErrorLogJSONObject(func(logger *slog.Logger) {
+//	    db := NewDatabase(logger)
+//	    db.Connect()
+//	}, map[string]any{
+//	    "level": "ERROR",
+//	    "msg":   "connection failed",
+//	    "host":  "localhost",
+//	    "port":  5432,
+//	})
+func ExpectErrorLogJSONObject(testFunc func(*slog.Logger), expected map[string]any) {
+	var want any = expected
+	if data, err := json.Marshal(expected); err == nil {
+		_ = json.Unmarshal(data, &want)
+	}
+
+	wantFields, _ := want.(map[string]any)
+	expectJSONRecord(testFunc, func(got map[string]any) (diffs []string, matched int) {
+		for _, key := range slices.Sorted(maps.Keys(wantFields)) {
+			value, ok := got[key]
+			if !ok {
+				diffs = append(diffs, fmt.Sprintf("missing key %q: want %s", key, jsonString(wantFields[key])))
+			} else if !reflect.DeepEqual(value, wantFields[key]) {
+				diffs = append(diffs, fmt.Sprintf("key %q: want %s, got %s", key, jsonString(wantFields[key]), displayValue(key, value)))
+			} else {
+				matched++
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(got)) {
+			if _, ok := wantFields[key]; !ok {
+				diffs = append(diffs, fmt.Sprintf("extra key %q: got %s", key, displayValue(key, got[key])))
+			}
+		}
+		return diffs, matched
+	}, fmt.Sprintf("equals %v", want))
 }
 
-// WithCapturedJSONLogger creates a JSON logger that writes to a gbytes.Buffer,
-// useful for validating structured log fields.
+// ExpectErrorLogJSONRecord captures JSON logs and validates that a single
+// record contains every expected field, so fields logged in different records
+// cannot combine into a false positive as they can with substring patterns.
+// Unlike ExpectErrorLogJSONObject, the record may carry other fields too.
 //
-// This is particularly useful when testing code that relies on structured
-// logging and you need to validate specific JSON fields in the log output.
+// Keys of grouped attributes are dotted paths such as "http.status", and
+// values are compared as in AssertLogAttr. Records that do not match are
+// written to GinkgoWriter for debugging. A failure reports the nearest record with
+// its missing keys and wrong values.
 //
 // Usage:
 //
-//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
-//	handler := NewHandler(logger)
-//	handler.HandleRequest(req)
-//	Expect(buffer).To(gbytes.Say(`"request_id":"123"`))
-func WithCapturedJSONLogger(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
-	buffer := gbytes.NewBuffer()
-	logger := slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
-		Level: level,
-	}))
-	return logger, buffer
+//	ExpectErrorLogJSONRecord(func(logger *slog.Logger) {
+//	    db := NewDatabase(logger)
+//	    db.Connect()
+//	}, map[string]any{
+//	    "msg":  "connection failed",
+//	    "host": "localhost",
+//	    "port": 5432,
+//	})
+func ExpectErrorLogJSONRecord(testFunc func(*slog.Logger), expectedFields map[string]any) {
+	expectJSONRecord(testFunc, func(got map[string]any) (diffs []string, matched int) {
+		for _, key := range slices.Sorted(maps.Keys(expectedFields)) {
+			want := normalizeAttrValue(expectedFields[key], FormatJSON)
+			value, ok := lookupAttr(got, key)
+			if !ok {
+				diffs = append(diffs, fmt.Sprintf("missing key %q: want %s", key, jsonString(want)))
+			} else if !attrEqual(value, expectedFields[key], FormatJSON) {
+				diffs = append(diffs, fmt.Sprintf("key %q: want %s, got %s", key, jsonString(want), displayValue(key, value)))
+			} else {
+				matched++
+			}
+		}
+		return diffs, matched
+	}, fmt.Sprintf("contains all of %v", expectedFields))
 }
 
-// AssertNoErrorLogs validates that no ERROR level logs were produced.
-// Useful for ensuring operations complete successfully without errors.
-//
-// This function checks for both text and JSON formatted error logs.
+// expectJSONRecord captures JSON logs without their time field and validates
+// that one record has no differences according to diff, which also counts
+// the expected fields a record matched. On failure, the nearest record, the
+// one matching the most expected fields and then having the fewest
+// differences, is reported alongside them, and the description names the
+// expectation. The remaining records are displayed as
+// unexpected logs.
+func expectJSONRecord(
+	testFunc func(*slog.Logger),
+	diff func(map[string]any) (diffs []string, matched int),
+	description string,
+) {
+	output := captureLogs(jsonHandler, &slog.HandlerOptions{
+		Level:       errorCaptureLevel(),
+		ReplaceAttr: removeTime,
+	}, testFunc)
+
+	matched := false
+	var unmatched []string
+	var nearest string
+	var nearestDiffs []string
+	nearestMatched := -1
+	for _, record := range splitRecords(output) {
+		var got map[string]any
+		if matched || json.Unmarshal([]byte(record), &got) != nil {
+			unmatched = append(unmatched, record)
+			continue
+		}
+		diffs, fields := diff(got)
+		if len(diffs) == 0 {
+			matched = true
+			continue
+		}
+		if fields > nearestMatched || (fields == nearestMatched && len(diffs) < len(nearestDiffs)) {
+			nearest, nearestDiffs, nearestMatched = record, diffs, fields
+		}
+		unmatched = append(unmatched, record)
+	}
+	if !matched && nearest != "" {
+		Expect(matched).To(BeTrue(),
+			"No JSON log record %s\nNearest record: %s\nDifferences:\n  %s\nCaptured records:\n%s",
+			description, redactRecord(nearest), strings.Join(nearestDiffs, "\n  "),
+			strings.Join(redactRecords(unmatched), "\n"))
+	} else {
+		Expect(matched).To(BeTrue(),
+			"No JSON log record %s\nCaptured records:\n%s", description, strings.Join(redactRecords(unmatched), "\n"))
+	}
+
+	printLogs(unmatched)
+}
+
+// displayValue renders a captured attribute value for a failure message,
+// hiding the values of redacted keys.
+func displayValue(key string, value any) string {
+	if isRedactedKey(key) {
+		return `"***"`
+	}
+	return jsonString(value)
+}
+
+// jsonString renders a decoded JSON value for a failure message.
+func jsonString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// ExpectErrorLogOrdered is like ExpectErrorLog but requires the expected
+// patterns to appear in the given order, which is useful when testing a state
+// machine whose logs must follow a specific progression.
+//
+// Only relative order is enforced: other logs may be interleaved between the
+// matches, and matches need not be adjacent. A failure names the first
+// pattern that could not be found after its predecessors.
+//
+// Usage:
+//
+//	ExpectErrorLogOrdered(func(logger *slog.Logger) {
+//	    machine := NewStateMachine(logger)
+//	    machine.Run()
+//	}, "state=connecting", "state=failed", "state=retrying")
+func ExpectErrorLogOrdered(testFunc func(*slog.Logger), expectedPatterns ...string) {
+	patterns := compilePatterns(expectedPatterns)
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: errorCaptureLevel()}, testFunc)
+
+	remaining := output
+	for _, re := range patterns {
+		loc := re.FindStringIndex(remaining)
+		if !Expect(loc).NotTo(BeNil(), "Expected error log pattern not found in order: %s", re) {
+			break
+		}
+		remaining = remaining[loc[1]:]
+	}
+
+	printUnexpectedLogs(output, patterns)
+}
+
+// ExpectErrorLogConsecutive is like ExpectErrorLogOrdered but requires the
+// patterns to match adjacent log records, with no other record between
+// them. This is useful for a tightly coupled sequence such as "begin tx"
+// immediately followed by "commit tx".
+//
+// Each pattern is matched against a single record, and the sequence may
+// start at any record. Records preceding or following the sequence are
+// allowed.
+//
+// Usage:
+//
+//	ExpectErrorLogConsecutive(func(logger *slog.Logger) {
+//	    repo := NewRepository(logger)
+//	    repo.SaveWithRollback()
+//	}, "begin tx", "rollback tx")
+func ExpectErrorLogConsecutive(testFunc func(*slog.Logger), expectedPatterns ...string) {
+	patterns := compilePatterns(expectedPatterns)
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: errorCaptureLevel()}, testFunc)
+	records := splitRecords(output)
+
+	windowMatches := func(start int) bool {
+		for i, re := range patterns {
+			if !re.MatchString(records[start+i]) {
+				return false
+			}
+		}
+		return true
+	}
+	found := len(patterns) == 0
+	for start := 0; !found && start+len(patterns) <= len(records); start++ {
+		found = windowMatches(start)
+	}
+	Expect(found).To(BeTrue(),
+		"Expected error log patterns on consecutive records: %s\nCaptured records:\n  %s",
+		strings.Join(expectedPatterns, ", "), strings.Join(redactRecords(records), "\n  "))
+
+	printUnexpectedLogs(output, patterns)
+}
+
+// ExpectErrorLogTimes is like ExpectErrorLog but validates that exactly
+// count log records match pattern, which is useful for retry scenarios such
+// as asserting "retrying" was logged three times.
+//
+// Occurrences are counted per log record: a multi-line message, or a record
+// matching the pattern more than once, counts once. Matching records are
+// hidden from output and unexpected logs are written to GinkgoWriter.
+//
+// Usage:
+//
+//	ExpectErrorLogTimes(func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallWithRetry()
+//	}, "retrying", 3)
+func ExpectErrorLogTimes(testFunc func(*slog.Logger), pattern string, count int) {
+	patterns := compilePatterns([]string{pattern})
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: errorCaptureLevel()}, testFunc)
+
+	matched := 0
+	for _, record := range splitRecords(output) {
+		if matchesAny(record, patterns) {
+			matched++
+		}
+	}
+	Expect(matched).To(Equal(count),
+		"Expected error log pattern %s to match %d time(s) but matched %d", pattern, count, matched)
+
+	printUnexpectedLogs(output, patterns)
+}
+
+// ExpectExactLogs runs a test function with a captured text logger and
+// validates that the logs it produced exactly equal expectedLines, in order.
+//
+// This is stricter than ExpectErrorLog: a missing, extra or reordered log
+// fails the assertion. The volatile time field is omitted from captured
+// records, so expected lines start with the level field.
+//
+// Usage:
+//
+//	ExpectExactLogs(func(logger *slog.Logger) {
+//	    service := NewService(logger)
+//	    service.ProcessInvalidData()
+//	}, `level=ERROR msg="validation failed" field=email`)
+func ExpectExactLogs(testFunc func(*slog.Logger), expectedLines ...string) {
+	output := captureLogs(textHandler, &slog.HandlerOptions{
+		Level:       errorCaptureLevel(),
+		ReplaceAttr: removeTime,
+	}, testFunc)
+
+	expected := make([]any, len(expectedLines))
+	for i, line := range expectedLines {
+		expected[i] = line
+	}
+	Expect(splitRecords(output)).To(HaveExactElements(expected...),
+		"Captured logs did not exactly match expected lines")
+}
+
+// LogExpectation describes a log expected at a specific level. Pattern is a
+// regular expression matched against records at exactly Level.
+type LogExpectation struct {
+	Level   slog.Level
+	Pattern string
+}
+
+// ExpectLog runs a test function with a captured logger and validates that
+// each expectation is met by a record at the expectation's level.
+//
+// Unlike ExpectErrorLog, a pattern that appears only at a different severity
+// does not satisfy the expectation, preventing false passes when a message
+// is logged as WARN instead of ERROR. Records matching an expectation at its
+// level are hidden from output; all other logs are written to GinkgoWriter.
+//
+// Usage:
+//
+//	ExpectLog(func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallWithFallback()
+//	},
+//	    LogExpectation{Level: slog.LevelWarn, Pattern: "primary unavailable"},
+//	    LogExpectation{Level: slog.LevelError, Pattern: "fallback failed"},
+//	)
+func ExpectLog(testFunc func(*slog.Logger), expectations ...LogExpectation) {
+	level := errorCaptureLevel()
+	patterns := make([]*regexp.Regexp, len(expectations))
+	for i, expectation := range expectations {
+		level = min(level, expectation.Level)
+		patterns[i] = compilePatterns([]string{expectation.Pattern})[0]
+	}
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: level}, testFunc)
+	records := splitRecords(output)
+
+	// matches reports whether record satisfies the expectation at index i
+	matches := func(record string, i int) bool {
+		recordLevel, ok := recordLevel(record)
+		return ok && recordLevel == expectations[i].Level && patterns[i].MatchString(record)
+	}
+
+	for i, expectation := range expectations {
+		found := slices.ContainsFunc(records, func(record string) bool { return matches(record, i) })
+		Expect(found).To(BeTrue(), "Expected %s log pattern not found: %s", expectation.Level, expectation.Pattern)
+	}
+
+	var unexpected []string
+	for _, record := range records {
+		expected := false
+		for i := range expectations {
+			expected = expected || matches(record, i)
+		}
+		if !expected {
+			unexpected = append(unexpected, record)
+		}
+	}
+	printLogs(unexpected)
+}
+
+// ExpectWarnLog is like ExpectErrorLog but validates expected WARN logs,
+// such as deprecation notices or fallback paths.
+//
+// Each pattern must appear on a WARN record. Matching WARN records are
+// hidden from output, while everything else, including any ERROR logs, is
+// written to GinkgoWriter.
+//
+// Usage:
+//
+//	ExpectWarnLog(func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallDeprecatedAPI()
+//	}, "deprecated endpoint", "use /v2 instead")
+func ExpectWarnLog(testFunc func(*slog.Logger), expectedPatterns ...string) {
+	expectations := make([]LogExpectation, len(expectedPatterns))
+	for i, pattern := range expectedPatterns {
+		expectations[i] = LogExpectation{Level: slog.LevelWarn, Pattern: pattern}
+	}
+	ExpectLog(testFunc, expectations...)
+}
+
+// ExpectLogAtLevel is like ExpectErrorLog but validates logs expected at an
+// arbitrary level, such as a WARN deprecation notice or an INFO audit entry.
+//
+// The captured logger is configured low enough to emit records at level,
+// even when LOG_LEVEL would otherwise suppress them. Expected logs are
+// hidden from output and unexpected logs are written to GinkgoWriter.
+//
+// Usage:
+//
+//	ExpectLogAtLevel(slog.LevelWarn, func(logger *slog.Logger) {
+//	    client := NewClient(logger)
+//	    client.CallDeprecatedAPI()
+//	}, "deprecated endpoint", "use /v2 instead")
+func ExpectLogAtLevel(level slog.Level, testFunc func(*slog.Logger), expectedPatterns ...string) {
+	expectErrorLogWithHandler(
+		textHandler,
+		min(level, getLogLevel()),
+		testFunc,
+		compilePatterns(expectedPatterns),
+	)
+}
+
+// WithCapturedLogger creates a logger that writes to a gbytes.Buffer,
+// allowing manual validation of log output using Gomega matchers.
+//
+// This provides fine-grained control over log validation when the automatic
+// pattern matching in ExpectErrorLog is not sufficient.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelError)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say("processing started"))
+func WithCapturedLogger(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	return NewCapture().Level(level).Build()
+}
+
+// WithCapturedLoggerDefault is like WithCapturedLogger but uses the level
+// from the LOG_LEVEL environment variable, as ConfigureTestLogging does, so a
+// suite captures at one consistent level without repeating it in every spec.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLoggerDefault()
+//	service := NewService(logger)
+//	service.ProcessData()
+//	AssertNoErrorLogs(buffer)
+func WithCapturedLoggerDefault() (*slog.Logger, *gbytes.Buffer) {
+	return WithCapturedLogger(getLogLevel())
+}
+
+// WithCapturedLoggerOpts is like WithCapturedLogger but accepts full handler
+// options, giving control over AddSource, ReplaceAttr and the level.
+//
+// This is useful for asserting which call site produced a log, or for
+// customizing the captured format without reimplementing the handler setup.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLoggerOpts(&slog.HandlerOptions{
+//	    Level:     slog.LevelDebug,
+//	    AddSource: true,
+//	})
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say(`source=.*service.go:\d+`))
+func WithCapturedLoggerOpts(opts *slog.HandlerOptions) (*slog.Logger, *gbytes.Buffer) {
+	buffer := gbytes.NewBuffer()
+	logger := slog.New(slog.NewTextHandler(buffer, opts))
+	return logger, buffer
+}
+
+// WithCapturedLoggerTee is like WithCapturedLogger but also forwards every
+// log to stderr, so captured logs can be watched live while debugging.
+//
+// The buffer receives logs at or above level. If the LOG_LEVEL environment
+// variable is set, it controls the level of the stderr copy instead.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLoggerTee(slog.LevelDebug)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say("processing started"))
+func WithCapturedLoggerTee(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	stderrLevel := level
+	if os.Getenv(logLevelEnvVar) != "" {
+		stderrLevel = getLogLevel()
+	}
+	buffer := gbytes.NewBuffer()
+	logger := slog.New(multiHandler{
+		slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: level}),
+		slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: stderrLevel}),
+	})
+	return logger, buffer
+}
+
+// WithCapturedContextLogger is like WithCapturedLogger but adds attributes
+// derived from the context passed to the *Context logging methods, such as a
+// request_id or trace_id stored by middleware.
+//
+// extract is called for every record with the context given to the logging
+// call and the returned attributes are attached to the record.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedContextLogger(slog.LevelInfo,
+//	    func(ctx context.Context) []slog.Attr {
+//	        if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+//	            return []slog.Attr{slog.String("request_id", id)}
+//	        }
+//	        return nil
+//	    })
+//	handler := NewHandler(logger)
+//	handler.ServeHTTP(rec, req)
+//	Expect(buffer).To(gbytes.Say("request_id=abc123"))
+func WithCapturedContextLogger(
+	level slog.Level,
+	extract func(context.Context) []slog.Attr,
+) (*slog.Logger, *gbytes.Buffer) {
+	buffer := gbytes.NewBuffer()
+	logger := slog.New(&contextHandler{
+		Handler: slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: level}),
+		extract: extract,
+	})
+	return logger, buffer
+}
+
+// WithCapturedLoggerGID is like WithCapturedLogger but adds a goroutine
+// attribute to every record holding the ID of the goroutine that logged it,
+// making it possible to follow and assert which goroutine produced which log
+// in concurrent tests.
+//
+// The ID is parsed from a runtime stack trace on every log call, which is
+// comparatively expensive, so use this only when goroutine identity matters.
+// Goroutine IDs are an implementation detail of the runtime: compare them
+// with each other rather than against fixed values.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLoggerGID(slog.LevelInfo)
+//	pool := NewWorkerPool(logger, 4)
+//	pool.Run()
+//	entries, err := Entries(buffer)
+//	Expect(err).NotTo(HaveOccurred())
+//	Expect(entries[0].Attrs).To(HaveKey("goroutine"))
+func WithCapturedLoggerGID(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	buffer := gbytes.NewBuffer()
+	logger := slog.New(&goroutineHandler{
+		Handler: slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: level}),
+	})
+	return logger, buffer
+}
+
+// WithCapturedLoggerWriter creates a text logger that writes to w, leaving
+// the caller in control of the destination.
+//
+// This supports capturing to a bytes.Buffer fed to a parser, a file kept for
+// post-mortem inspection, or a pipe, without reimplementing handler setup.
+//
+// Usage:
+//
+//	var output bytes.Buffer
+//	logger := WithCapturedLoggerWriter(&output, slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(output.String()).To(ContainSubstring("processing complete"))
+func WithCapturedLoggerWriter(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+	}))
+}
+
+// WithCapturedStdLogger creates a standard library *log.Logger backed by a
+// captured slog text handler, for code that predates slog and accepts a
+// *log.Logger. Each Print call becomes a record at level, so its output can
+// be checked with the usual buffer assertions.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedStdLogger(slog.LevelWarn)
+//	server := &http.Server{ErrorLog: logger}
+//	go server.Serve(listener)
+//	Eventually(buffer).Should(gbytes.Say(`level=WARN msg="http: TLS handshake error`))
+func WithCapturedStdLogger(level slog.Level) (*log.Logger, *gbytes.Buffer) {
+	buffer := gbytes.NewBuffer()
+	handler := slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: level})
+	return slog.NewLogLogger(handler, level), buffer
+}
+
+// WithCapturedLoggerFile creates a text logger that writes to a new temporary
+// file, returning the file's path and a cleanup function that closes and
+// removes it. Records are written straight to the file, so it can be read or
+// attached to a CI report at any point before cleanup.
+//
+// Usage:
+//
+//	logger, path, cleanup := WithCapturedLoggerFile(slog.LevelDebug)
+//	DeferCleanup(func() {
+//	    if CurrentSpecReport().Failed() {
+//	        os.Rename(path, filepath.Join(artifactDir, filepath.Base(path)))
+//	    }
+//	    cleanup()
+//	})
+//	service := NewService(logger)
+//	service.ProcessData()
+func WithCapturedLoggerFile(level slog.Level) (*slog.Logger, string, func()) {
+	file, err := os.CreateTemp("", "testlogger-*.log")
+	Expect(err).NotTo(HaveOccurred(), "Failed to create temporary log file")
+
+	cleanup := func() {
+		file.Close()
+		os.Remove(file.Name())
+	}
+	return WithCapturedLoggerWriter(file, level), file.Name(), cleanup
+}
+
+// WithCapturedLoggerClock is like WithCapturedLogger but stamps every record
+// with the time returned by now instead of the wall clock.
+//
+// Passing a fixed or incrementing fake clock makes timestamp-sensitive
+// assertions and golden output deterministic.
+//
+// Usage:
+//
+//	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	logger, buffer := WithCapturedLoggerClock(slog.LevelInfo, func() time.Time {
+//	    return start
+//	})
+//	logger.Info("tick")
+//	Expect(buffer).To(gbytes.Say("time=2025-01-01T00:00:00.000Z"))
+func WithCapturedLoggerClock(level slog.Level, now func() time.Time) (*slog.Logger, *gbytes.Buffer) {
+	return NewCapture().Level(level).Clock(now).Build()
+}
+
+// WithFailOnErrorLogger creates a logger that fails the test the moment an
+// ERROR or higher record is logged, with the record and its call site in the
+// failure. Under Ginkgo the failure interrupts the spec at the offending log
+// call, so the culprit is obvious from the stack trace.
+//
+// ERROR records matching any of the allowed regular expressions are tolerated,
+// for long tests with a few known-benign errors. Patterns are matched against
+// the record's level, message and attributes in text format, including
+// attributes added with Logger.With, such as
+// `level=ERROR msg="retrying" component=cache attempt=2`.
+//
+// Records at or above the LOG_LEVEL setting, including tolerated errors, are
+// also written to GinkgoWriter.
+//
+// Like any Ginkgo failure, the failure panics to stop the spec, so a goroutine
+// that logs through this logger must start with defer GinkgoRecover() or the
+// panic crashes the test binary.
+//
+// Usage:
+//
+//	logger := WithFailOnErrorLogger("cache miss")
+//	service := NewService(logger)
+//	service.ProcessValidData() // fails here if anything else logs an error
+func WithFailOnErrorLogger(allowed ...string) *slog.Logger {
+	return slog.New(newFailOnErrorHandler(
+		slog.NewTextHandler(ginkgo.GinkgoWriter, &slog.HandlerOptions{Level: getLogLevel()}),
+		compilePatterns(allowed),
+	))
+}
+
+// WithCountingLogger creates a logger that discards its output but counts
+// every record at or above level. The returned counter is updated
+// atomically, so read it with atomic.LoadInt64 while other goroutines may
+// still be logging.
+//
+// Nothing is formatted or buffered, which keeps memory flat in benchmarks
+// of logging-heavy code where a gbytes.Buffer would grow without bound.
+//
+// Usage:
+//
+//	logger, count := WithCountingLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	for b.Loop() {
+//		service.ProcessData()
+//	}
+//	b.ReportMetric(float64(atomic.LoadInt64(count))/float64(b.N), "logs/op")
+func WithCountingLogger(level slog.Level) (*slog.Logger, *int64) {
+	count := new(int64)
+	return slog.New(&countingHandler{count: count, level: level}), count
+}
+
+// WithCapturedProblemLogger is like WithCapturedLogger but fixed at
+// slog.LevelWarn, capturing only potentially problematic logs: warnings and
+// errors. INFO and DEBUG noise is excluded, so the buffer holds just the
+// combined warning and error stream.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedProblemLogger()
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say("level=WARN msg=\"retrying\""))
+func WithCapturedProblemLogger() (*slog.Logger, *gbytes.Buffer) {
+	return WithCapturedLogger(slog.LevelWarn)
+}
+
+// WithCapturedJSONLogger creates a JSON logger that writes to a gbytes.Buffer,
+// useful for validating structured log fields.
+//
+// This is particularly useful when testing code that relies on structured
+// logging and you need to validate specific JSON fields in the log output.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	handler := NewHandler(logger)
+//	handler.HandleRequest(req)
+//	Expect(buffer).To(gbytes.Say(`"request_id":"123"`))
+func WithCapturedJSONLogger(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	return NewCapture().Level(level).JSON().Build()
+}
+
+// WithCapturedLoggerStable is like WithCapturedLogger but omits the time
+// field from every record, so captured output is identical across runs.
+//
+// This allows full log lines to be compared byte-for-byte, for example
+// against golden files.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLoggerStable(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(string(buffer.Contents())).To(Equal("level=INFO msg=done count=3\n"))
+func WithCapturedLoggerStable(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	return NewCapture().Level(level).Stable().Build()
+}
+
+// WithCapturedJSONLoggerStable is like WithCapturedJSONLogger but sets the
+// time field of every record to the zero time, so captured output is
+// identical across runs while keeping the field present for JSON consumers.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLoggerStable(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say(`{"time":"0001-01-01T00:00:00Z","level":"INFO","msg":"done"}`))
+func WithCapturedJSONLoggerStable(level slog.Level) (*slog.Logger, *gbytes.Buffer) {
+	return NewCapture().Level(level).JSON().Stable().Build()
+}
+
+// Snapshot returns a reader over a copy of everything captured so far,
+// independent of the gbytes read cursor. Reading it does not advance the
+// cursor used by gbytes.Say, and later writes to the buffer do not affect
+// it, so each call supports an independent scan such as counting lines,
+// parsing JSON or searching with a regular expression.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	Expect(buffer).To(gbytes.Say("processing started"))
+//	decoder := json.NewDecoder(Snapshot(buffer)) // still sees every record
+func Snapshot(buffer *gbytes.Buffer) io.Reader {
+	return bytes.NewReader(buffer.Contents())
+}
+
+// ResetBuffer discards the contents of a captured log buffer and rewinds its
+// read cursor, so a captured logger can be reused across iterations of a
+// table-driven spec without output leaking from one iteration to the next.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	for _, input := range inputs {
+//	    ResetBuffer(buffer)
+//	    Process(logger, input)
+//	    AssertLogContains(buffer, "processed "+input)
+//	}
+func ResetBuffer(buffer *gbytes.Buffer) {
+	Expect(buffer.Clear()).To(Succeed(), "Failed to reset log buffer")
+}
+
+// PollableBuffer wraps a captured log buffer for polling assertions on
+// asynchronous logging. Unlike gbytes.Say, which advances the buffer's read
+// cursor so repeated Eventually polls can miss output, Contains scans the
+// full contents every time.
+//
+// PollableBuffer implements gbytes.BufferProvider, so gbytes.Say can still
+// be applied to it directly.
+type PollableBuffer struct {
+	buffer *gbytes.Buffer
+}
+
+// NewPollableBuffer wraps buffer for use with Eventually and Consistently.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	go worker.Run(logger)
+//	pollable := NewPollableBuffer(buffer)
+//	Eventually(func() bool { return pollable.Contains("done") }).Should(BeTrue())
+func NewPollableBuffer(buffer *gbytes.Buffer) *PollableBuffer {
+	return &PollableBuffer{buffer: buffer}
+}
+
+// Buffer returns the wrapped buffer.
+func (b *PollableBuffer) Buffer() *gbytes.Buffer {
+	return b.buffer
+}
+
+// Contains reports whether pattern, a regular expression as accepted by
+// gbytes.Say, has matched anything captured so far. It does not read or
+// move the buffer's cursor, so it returns true on every call once the
+// pattern has appeared.
+func (b *PollableBuffer) Contains(pattern string) bool {
+	return compilePatterns([]string{pattern})[0].Match(b.buffer.Contents())
+}
+
+// preciseTime is a ReplaceAttr function that renders the top-level time
+// attribute with nanosecond precision, rather than the milliseconds used by
+// slog.TextHandler, so records can be ordered reliably.
+func preciseTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey && a.Value.Kind() == slog.KindTime {
+		return slog.String(a.Key, a.Value.Time().Format(time.RFC3339Nano))
+	}
+	return a
+}
+
+// removeTime is a ReplaceAttr function that drops the top-level time attribute.
+func removeTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+	return a
+}
+
+// zeroTime is a ReplaceAttr function that replaces the top-level time
+// attribute with the zero time.
+func zeroTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.Time(slog.TimeKey, time.Time{})
+	}
+	return a
+}
+
+// AssertLogContains validates that each pattern appears in the captured
+// log output. Patterns are regular expressions, as with gbytes.Say.
+//
+// The full buffer contents are scanned for every pattern, so the gbytes read
+// cursor is ignored and patterns may appear in any order.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	AssertLogContains(buffer, "processing started", `count=\d+`)
+func AssertLogContains(buffer *gbytes.Buffer, patterns ...string) {
+	contents := string(buffer.Contents())
+	for _, pattern := range patterns {
+		Expect(contents).To(MatchRegexp(pattern),
+			"Expected log pattern not found: %s", pattern)
+	}
+}
+
+// AssertLogContainsAll validates that every pattern matches the captured
+// output, in any order. Unlike AssertLogContains, a single failure lists all
+// of the missing patterns. Patterns are regular expressions scanned against
+// the full buffer contents, so the gbytes read cursor is ignored.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.Start()
+//	AssertLogContainsAll(buffer, "cache warmed", "listener ready", "metrics enabled")
+func AssertLogContainsAll(buffer *gbytes.Buffer, patterns ...string) {
+	contents := string(buffer.Contents())
+	var missing []string
+	for i, re := range compilePatterns(patterns) {
+		if !re.MatchString(contents) {
+			missing = append(missing, patterns[i])
+		}
+	}
+	Expect(missing).To(BeEmpty(), "Expected log patterns not found: %q", missing)
+}
+
+// AssertLogContainsAny validates that at least one pattern matches the
+// captured output, failing with the full list of patterns if none do.
+// Patterns are regular expressions scanned against the full buffer contents,
+// so the gbytes read cursor is ignored.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	client := NewClient(logger)
+//	client.Connect()
+//	AssertLogContainsAny(buffer, "connected via IPv4", "connected via IPv6")
+func AssertLogContainsAny(buffer *gbytes.Buffer, patterns ...string) {
+	contents := string(buffer.Contents())
+	found := slices.ContainsFunc(compilePatterns(patterns), func(re *regexp.Regexp) bool {
+		return re.MatchString(contents)
+	})
+	Expect(found).To(BeTrue(), "None of the expected log patterns were found: %q", patterns)
+}
+
+// AssertLogSequence validates that the patterns match captured records in
+// order: each pattern must match a record logged after the one matched by
+// the previous pattern. Records in between are ignored.
+//
+// On failure it reports the index of the first pattern that could not be
+// matched in order, pinpointing where the sequence broke.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	workflow := NewWorkflow(logger)
+//	workflow.Run()
+//	AssertLogSequence(buffer, []string{"order received", "payment captured", "order shipped"})
+func AssertLogSequence(buffer *gbytes.Buffer, patterns []string) {
+	records := splitRecords(string(buffer.Contents()))
+	next := 0
+	for i, pattern := range compilePatterns(patterns) {
+		for next < len(records) && !pattern.MatchString(records[next]) {
+			next++
+		}
+		if !Expect(next).To(BeNumerically("<", len(records)),
+			"Log sequence broken at pattern %d: %s not found after the previous match", i, patterns[i]) {
+			return
+		}
+		next++
+	}
+}
+
+// AssertLogNotContains validates that none of the patterns appear anywhere
+// in the captured log output. Patterns are regular expressions.
+//
+// This is useful for confirming that sensitive data such as passwords or
+// tokens never reach the logs. The full buffer contents are scanned, so the
+// gbytes read cursor is ignored.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	auth := NewAuthenticator(logger)
+//	auth.Login("user", "hunter2")
+//	AssertLogNotContains(buffer, "hunter2", `token=\S+`)
+func AssertLogNotContains(buffer *gbytes.Buffer, patterns ...string) {
+	contents := string(buffer.Contents())
+	for _, pattern := range patterns {
+		Expect(contents).NotTo(MatchRegexp(pattern),
+			"Forbidden log pattern found: %s", pattern)
+	}
+}
+
+// AssertNoLogs validates that nothing at all was logged, ignoring trailing
+// whitespace such as a lone newline. On failure the unexpected output is
+// included in the message so the culprit is easy to find.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	cache := NewCache(logger)
+//	cache.Get("hot-key")
+//	AssertNoLogs(buffer)
+func AssertNoLogs(buffer *gbytes.Buffer) {
+	contents := strings.TrimSpace(string(buffer.Contents()))
+	Expect(contents).To(BeEmpty(), "Expected no logs but found:\n%s", contents)
+}
+
+// AssertNoErrorLogs validates that no ERROR level logs were produced.
+// Useful for ensuring operations complete successfully without errors.
+//
+// This function checks for both text and JSON formatted error logs.
 //
 // Usage:
 //
@@ -200,3 +1693,97 @@ func AssertNoErrorLogs(buffer *gbytes.Buffer) {
 	Expect(string(contents)).NotTo(ContainSubstring(`"level":"ERROR"`),
 		"Unexpected ERROR log found in JSON output")
 }
+
+// AssertNoWarnLogs validates that no WARN level logs were produced.
+// Useful for ensuring happy-path operations complete without warnings.
+//
+// Unlike a plain substring search, this matches the structured level field
+// of each record in both text and JSON output, so a message that merely
+// contains the text "WARN" does not produce a false positive.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	service := NewService(logger)
+//	service.ProcessValidData()
+//	AssertNoWarnLogs(buffer)
+func AssertNoWarnLogs(buffer *gbytes.Buffer) {
+	warnings := recordsInLevelRange(string(buffer.Contents()), slog.LevelWarn, slog.LevelError)
+	Expect(warnings).To(BeEmpty(),
+		"Unexpected WARN log found in output:\n%s", strings.Join(warnings, "\n"))
+}
+
+// AssertNoProblems validates that no WARN or ERROR level logs, or anything
+// more severe, were produced. It pairs with WithCapturedProblemLogger to
+// check that a happy path stays quiet.
+//
+// Like AssertNoWarnLogs, this matches the structured level field of each
+// record in text and JSON output rather than searching for substrings.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedProblemLogger()
+//	service := NewService(logger)
+//	service.ProcessValidData()
+//	AssertNoProblems(buffer)
+func AssertNoProblems(buffer *gbytes.Buffer) {
+	problems := recordsInLevelRange(string(buffer.Contents()), slog.LevelWarn, slog.Level(math.MaxInt))
+	Expect(problems).To(BeEmpty(),
+		"Unexpected WARN or ERROR log found in output:\n%s", strings.Join(problems, "\n"))
+}
+
+// WaitForLogs blocks until buffer holds at least expectedCount log records,
+// failing the test if timeout elapses first. It replaces time.Sleep calls in
+// tests whose code logs from fire-and-forget goroutines after returning.
+// Records are counted as in AssertMaxLogLines, and the buffer is polled with
+// Gomega's Eventually at its default interval.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	notifier := NewNotifier(logger)
+//	notifier.SendAsync(3) // logs once per message from background goroutines
+//	WaitForLogs(buffer, 3, time.Second)
+//	AssertLogLevelCount(buffer, slog.LevelInfo, 3)
+func WaitForLogs(buffer *gbytes.Buffer, expectedCount int, timeout time.Duration) {
+	Eventually(func() int {
+		return len(splitRecords(string(buffer.Contents())))
+	}, timeout).Should(BeNumerically(">=", expectedCount),
+		"Expected at least %d log records within %s", expectedCount, timeout)
+}
+
+// AssertMaxLogLines validates that no more than maxRecords log records were
+// captured, guarding against log spam such as a log statement moved inside a
+// tight loop. Records are counted rather than physical lines, so multi-line
+// messages count once.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	processor := NewBatchProcessor(logger)
+//	processor.Process(items)
+//	AssertMaxLogLines(buffer, 5)
+func AssertMaxLogLines(buffer *gbytes.Buffer, maxRecords int) {
+	records := splitRecords(string(buffer.Contents()))
+	Expect(len(records)).To(BeNumerically("<=", maxRecords),
+		"Expected at most %d log records but found %d", maxRecords, len(records))
+}
+
+// AssertLogLevelCount validates that exactly expected log entries were
+// produced at the given level.
+//
+// Both text and JSON output are supported. Entries are counted as logical
+// records, so a message spanning several lines counts once.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	client := NewClient(logger)
+//	client.CallWithRetry()
+//	AssertLogLevelCount(buffer, slog.LevelWarn, 3)
+//	AssertLogLevelCount(buffer, slog.LevelError, 1)
+func AssertLogLevelCount(buffer *gbytes.Buffer, level slog.Level, expected int) {
+	actual := len(recordsInLevelRange(string(buffer.Contents()), level, level+1))
+	Expect(actual).To(Equal(expected),
+		"Expected %d %s log(s) but found %d", expected, level, actual)
+}
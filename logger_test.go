@@ -2,11 +2,18 @@ package testlogger_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"io"
+	"log"
 	"log/slog"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -62,105 +69,1464 @@ var _ = Describe("Logger Test Utilities", func() {
 		})
 
 		It("should programmatically verify filtering behavior", func() {
-			// Capture stderr to verify filtering works correctly
-			originalStderr := os.Stderr
-			r, w, _ := os.Pipe()
-			os.Stderr = w
+			// Capture unexpected log output to verify filtering works correctly
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			// Run test with multiple logs - only validate one pattern
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Test expected: should be hidden")
+				logger.Error("Test unexpected: should be visible")
+			}, "Test expected")
+
+			// Read captured output
+			output := buf.String()
+
+			// Expected log should be hidden (NOT in output)
+			Expect(output).NotTo(ContainSubstring("Test expected: should be hidden"))
+
+			// Unexpected log should be visible (IN output)
+			Expect(output).To(ContainSubstring("Test unexpected: should be visible"))
+		})
+		It("should hide logs matching regular expression patterns", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Request failed", "status", 503)
+				logger.Error("Unrelated failure")
+			}, `status=\d+`)
+
+			output := buf.String()
+
+			Expect(output).NotTo(ContainSubstring("Request failed"))
+			Expect(output).To(ContainSubstring("Unrelated failure"))
+		})
+	})
+
+	Describe("SetShowUnexpectedLogs", func() {
+		AfterEach(func() {
+			testlogger.SetShowUnexpectedLogs(true)
+		})
+
+		It("should validate patterns without displaying unexpected logs", func() {
+			testlogger.SetShowUnexpectedLogs(false)
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+					logger.Error("Unexpected error: should stay quiet")
+				}, "Missing pattern")
+			})
+
+			Expect(failures).To(HaveLen(1))
+			Expect(buf.String()).To(BeEmpty())
+		})
+	})
+
+	Describe("SetUnexpectedLogWriter", func() {
+		It("should write unexpected logs to GinkgoWriter by default", func() {
+			var buf bytes.Buffer
+			GinkgoWriter.TeeTo(&buf)
+			DeferCleanup(GinkgoWriter.ClearTeeWriters)
+
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Unexpected failure")
+			}, "Expected failure")
+
+			Expect(buf.String()).NotTo(ContainSubstring("Expected failure"))
+			Expect(buf.String()).To(ContainSubstring("Unexpected failure"))
+		})
+	})
+
+	Describe("ExpectErrorLogReturns", func() {
+		It("should pass when the function fails and logs", func() {
+			testlogger.ExpectErrorLogReturns(func(logger *slog.Logger) error {
+				logger.Error("API call failed", "status", 429)
+				return errors.New("rate limited")
+			}, "API call failed", "status=429")
+		})
+
+		It("should report a nil error", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogReturns(func(logger *slog.Logger) error {
+					logger.Error("API call failed")
+					return nil
+				}, "API call failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected test function to return an error, but it returned nil"))
+		})
+
+		It("should report a missing log pattern separately", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogReturns(func(logger *slog.Logger) error {
+					return errors.New("rate limited")
+				}, "API call failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log pattern not found: API call failed"))
+		})
+	})
+
+	Describe("ExpectNoErrorLog", func() {
+		It("should pass when no ERROR logs are produced", func() {
+			testlogger.ExpectNoErrorLog(func(logger *slog.Logger) {
+				logger.Warn("Slow response", "duration_ms", 900)
+			})
+		})
+
+		It("should surface ERROR logs in the failure", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectNoErrorLog(func(logger *slog.Logger) {
+					logger.Error("Database connection failed", "host", "localhost")
+				})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`msg="Database connection failed" host=localhost`))
+		})
+	})
+
+	Describe("SetColorOutput", func() {
+		AfterEach(func() {
+			testlogger.SetColorOutput(false)
+		})
+
+		It("should not color output written to a non-terminal", func() {
+			testlogger.SetColorOutput(true)
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Unexpected failure")
+			}, "Expected failure")
+
+			Expect(buf.String()).To(ContainSubstring("Unexpected failure"))
+			Expect(buf.String()).NotTo(ContainSubstring("\x1b["))
+		})
+	})
+
+	Describe("SetFailFast", func() {
+		AfterEach(func() {
+			testlogger.SetFailFast(false)
+		})
+
+		It("should report every missing pattern in one failure by default", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+					logger.Error("Request failed", "status", 503)
+				}, "Rate limit exceeded", "Request failed", `retry_after=\d+`)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log patterns not found:\n  Rate limit exceeded\n  retry_after=\\d+"))
+		})
+
+		It("should include the captured logs in the failure", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+					logger.Error("Request failed", "status", 503)
+					logger.Error("Giving up")
+				}, "Rate limit exceeded")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(MatchRegexp(`Captured logs:\n  time=\S+ level=ERROR msg="Request failed" status=503\n  time=\S+ level=ERROR msg="Giving up"`))
+		})
+
+		It("should note when nothing was captured", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {}, "Rate limit exceeded")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("No logs were captured"))
+		})
+
+		It("should stop at the first missing pattern when enabled", func() {
+			testlogger.SetFailFast(true)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+					logger.Error("Request failed", "status", 503)
+				}, "Rate limit exceeded", `retry_after=\d+`)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log pattern not found: Rate limit exceeded"))
+			Expect(failures[0]).NotTo(ContainSubstring("retry_after"))
+		})
+	})
+
+	Describe("ExpectErrorLogCapture", func() {
+		It("should return the complete captured output", func() {
+			output := testlogger.ExpectErrorLogCapture(func(logger *slog.Logger) {
+				logger.Error("Rate limit exceeded", "status", 429)
+				logger.Error("Retry scheduled", "delay", "1s")
+			}, "Rate limit exceeded")
+
+			Expect(strings.Count(output, "\n")).To(Equal(2))
+			Expect(output).To(ContainSubstring("status=429"))
+			Expect(output).To(ContainSubstring("delay=1s"))
+		})
+	})
+
+	Describe("SetRedactedKeys", func() {
+		var displayed bytes.Buffer
+
+		BeforeEach(func() {
+			displayed.Reset()
+			testlogger.SetUnexpectedLogWriter(&displayed)
+		})
+
+		AfterEach(func() {
+			testlogger.SetUnexpectedLogWriter(nil)
+			testlogger.SetRedactedKeys([]string{"password", "token", "secret", "api_key", "authorization"})
+		})
+
+		It("should redact sensitive values in displayed logs by default", func() {
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Login failed", "user", "abc123", "Password", "hunter2",
+					slog.Group("auth", "token", "secret-token"))
+			}, "Expected failure")
+
+			Expect(displayed.String()).To(ContainSubstring("user=abc123"))
+			Expect(displayed.String()).To(ContainSubstring("Password=***"))
+			Expect(displayed.String()).To(ContainSubstring("auth.token=***"))
+			Expect(displayed.String()).NotTo(ContainSubstring("hunter2"))
+			Expect(displayed.String()).NotTo(ContainSubstring("secret-token"))
+		})
+
+		It("should redact JSON records, leaving groups intact", func() {
+			testlogger.ExpectErrorLogJSON(func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Login failed", "password", "hunter2", "api_key", 42,
+					slog.Group("token", "kind", "bearer"))
+			}, "Expected failure")
+
+			Expect(displayed.String()).To(ContainSubstring(`"password":"***","api_key":"***","token":{"kind":"bearer"}`))
+		})
+
+		It("should match patterns and return output on the raw logs", func() {
+			output := testlogger.ExpectErrorLogCapture(func(logger *slog.Logger) {
+				logger.Error("Login failed", "token", "abc123", "password", "hunter2")
+			}, "token=abc123")
+
+			Expect(output).To(ContainSubstring("password=hunter2"))
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogNotContains(gbytes.BufferWithBytes([]byte(output)), "hunter2")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+
+		It("should redact captured logs in failure messages", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+					logger.Error("Login failed", "password", "hunter2")
+				}, "Rate limit exceeded")
+			})
+
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("password=***"))
+			Expect(failures[0]).NotTo(ContainSubstring("hunter2"))
+		})
+
+		It("should redact configured keys", func() {
+			testlogger.SetRedactedKeys([]string{"ssn"})
+
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Lookup failed", "ssn", "123-45-6789", "password", "visible")
+			}, "Expected failure")
+
+			Expect(displayed.String()).To(ContainSubstring("ssn=***"))
+			Expect(displayed.String()).To(ContainSubstring("password=visible"))
+		})
+	})
+
+	Describe("ExpectErrorLogRe", func() {
+		rateLimited := regexp.MustCompile(`(?i)RATE LIMIT exceeded`)
+
+		It("should validate and hide logs using compiled patterns", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLogRe(func(logger *slog.Logger) {
+				logger.Error("Rate limit exceeded", "status", 429)
+				logger.Error("Unrelated failure")
+			}, rateLimited, regexp.MustCompile(`status=\d+`))
+
+			Expect(buf.String()).NotTo(ContainSubstring("Rate limit exceeded"))
+			Expect(buf.String()).To(ContainSubstring("Unrelated failure"))
+		})
+
+		It("should report a missing compiled pattern", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogRe(func(logger *slog.Logger) {
+					logger.Error("Unrelated failure")
+				}, rateLimited)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log pattern not found: (?i)RATE LIMIT exceeded"))
+		})
+	})
+
+	Describe("ExpectErrorLogLiteral", func() {
+		It("should match metacharacters literally", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLogLiteral(func(logger *slog.Logger) {
+				logger.Error("[database] connection failed (attempt 3)")
+				logger.Error("database connection failed attempt 3")
+			}, "[database] connection failed (attempt 3)")
+
+			Expect(buf.String()).NotTo(ContainSubstring("[database]"))
+			Expect(buf.String()).To(ContainSubstring("database connection failed attempt 3"))
+		})
+
+		It("should fail when the literal is missing", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogLiteral(func(logger *slog.Logger) {
+					logger.Error("database connection failed")
+				}, "[database]")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("ExpectErrorLogWithHandler", func() {
+		// bareHandler omits the time and level fields, like many custom formats
+		bareHandler := func(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+			return slog.NewTextHandler(w, &slog.HandlerOptions{
+				Level: opts.Level,
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})
+		}
+
+		It("should validate and filter logs from a custom handler", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLogWithHandler(bareHandler, func(logger *slog.Logger) {
+				logger.Error("Rate limit exceeded", "status", 429)
+				logger.Error("Unrelated failure")
+			}, `msg="Rate limit exceeded" status=429`)
+
+			Expect(buf.String()).To(Equal("msg=\"Unrelated failure\"\n"))
+		})
+	})
+
+	Describe("ExpectErrorLogEventually", func() {
+		It("should wait for logs written by background goroutines", func() {
+			testlogger.ExpectErrorLogEventually(func(logger *slog.Logger) {
+				go func() {
+					time.Sleep(20 * time.Millisecond)
+					logger.Error("Job failed", "id", 7)
+					logger.Error("Retry scheduled")
+				}()
+			}, time.Second, "Job failed", "Retry scheduled")
+		})
+
+		It("should fail when the pattern does not appear before the timeout", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogEventually(func(logger *slog.Logger) {
+					logger.Error("Unrelated failure")
+				}, 50*time.Millisecond, "Job failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log pattern not found within 50ms: Job failed"))
+		})
+
+		It("should apply the timeout once across all patterns", func() {
+			start := time.Now()
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogEventually(func(logger *slog.Logger) {
+					logger.Error("Unrelated failure")
+				}, 100*time.Millisecond, "Job failed", "Retry scheduled", "Job abandoned")
+			})
+			Expect(time.Since(start)).To(BeNumerically("<", 250*time.Millisecond))
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected error log pattern not found within 100ms: Job failed"))
+		})
+
+		It("should require patterns in order and report the first missing one", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogEventually(func(logger *slog.Logger) {
+					logger.Error("Retry scheduled")
+					logger.Error("Job failed")
+				}, 50*time.Millisecond, "Job failed", "Retry scheduled")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("not found within 50ms: Retry scheduled"))
+		})
+	})
+
+	Describe("ExpectErrorLogUnder", func() {
+		It("should pass when patterns match within the time limit", func() {
+			testlogger.ExpectErrorLogUnder(time.Second, func(logger *slog.Logger) {
+				logger.Error("Cache miss", "key", "user:7")
+			}, "Cache miss")
+		})
+
+		It("should fail when the test function is too slow", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogUnder(10*time.Millisecond, func(logger *slog.Logger) {
+					time.Sleep(30 * time.Millisecond)
+					logger.Error("Cache miss")
+				}, "Cache miss")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to complete within 10ms but it took"))
+		})
+
+		It("should fail when a pattern is missing", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogUnder(time.Second, func(logger *slog.Logger) {
+					logger.Error("Loaded from database")
+				}, "Cache miss")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("pattern not found: Cache miss"))
+		})
+	})
+
+	Describe("ExpectErrorLogContext", func() {
+		type requestIDKey struct{}
+
+		It("should pass the context through to the test function", func() {
+			ctx := context.WithValue(context.Background(), requestIDKey{}, "req-42")
+
+			testlogger.ExpectErrorLogContext(ctx, func(ctx context.Context, logger *slog.Logger) {
+				logger.ErrorContext(ctx, "Request failed", "request_id", ctx.Value(requestIDKey{}))
+			}, "Request failed", "request_id=req-42")
+		})
+	})
+
+	Describe("ExpectErrorLogJSON", func() {
+		It("should validate JSON formatted error logs", func() {
+			testlogger.ExpectErrorLogJSON(func(logger *slog.Logger) {
+				logger.Error("Database connection failed",
+					"host", "localhost",
+					"port", 5432,
+					"error", errors.New("connection refused"))
+			}, `"level":"ERROR"`, `"msg":"Database connection failed"`, `"host":"localhost"`, `"port":5432`)
+		})
+	})
+
+	Describe("ExpectErrorLogJSONObject", func() {
+		It("should match a record equal to the expected object", func() {
+			testlogger.ExpectErrorLogJSONObject(func(logger *slog.Logger) {
+				logger.Error("Unrelated noise")
+				logger.Error("Database connection failed",
+					"host", "localhost",
+					"port", 5432,
+					slog.Group("retry", "attempt", 3))
+			}, map[string]any{
+				"level": "ERROR",
+				"msg":   "Database connection failed",
+				"host":  "localhost",
+				"port":  5432,
+				"retry": map[string]any{"attempt": 3},
+			})
+		})
+
+		It("should fail when the record has extra fields", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogJSONObject(func(logger *slog.Logger) {
+					logger.Error("Database connection failed", "host", "localhost", "password", "hunter2")
+				}, map[string]any{
+					"level": "ERROR",
+					"msg":   "Database connection failed",
+					"host":  "localhost",
+				})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("No JSON log record equals"))
+			Expect(failures[0]).To(ContainSubstring(`"password":"***"`))
+		})
+
+		It("should report a key-by-key diff against the nearest record", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogJSONObject(func(logger *slog.Logger) {
+					logger.Error("Unrelated noise")
+					logger.Error("Database connection failed", "host", "db.internal", "retry", true)
+				}, map[string]any{
+					"level": "ERROR",
+					"msg":   "Database connection failed",
+					"host":  "localhost",
+					"port":  5432,
+				})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`Nearest record: {"level":"ERROR","msg":"Database connection failed"`))
+			Expect(failures[0]).To(ContainSubstring("Differences:\n" +
+				`  key "host": want "localhost", got "db.internal"` + "\n" +
+				`  missing key "port": want 5432` + "\n" +
+				`  extra key "retry": got true` + "\n"))
+		})
+	})
+
+	Describe("ExpectErrorLogJSONRecord", func() {
+		It("should match a record containing every expected field", func() {
+			testlogger.ExpectErrorLogJSONRecord(func(logger *slog.Logger) {
+				logger.Error("Database connection failed",
+					"host", "localhost",
+					"port", 5432,
+					"attempt", 3,
+					slog.Group("pool", "size", 10))
+			}, map[string]any{
+				"msg":       "Database connection failed",
+				"host":      "localhost",
+				"port":      5432,
+				"pool.size": 10,
+			})
+		})
+
+		It("should fail when the fields are spread across records", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogJSONRecord(func(logger *slog.Logger) {
+					logger.Error("Resolving host", "host", "localhost")
+					logger.Error("Connecting", "port", 5432)
+				}, map[string]any{
+					"host": "localhost",
+					"port": 5432,
+				})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("No JSON log record contains all of map[host:localhost port:5432]"))
+			Expect(failures[0]).To(ContainSubstring(`Nearest record: {"level":"ERROR","msg":"Resolving host","host":"localhost"}`))
+			Expect(failures[0]).To(ContainSubstring(`missing key "port": want 5432`))
+		})
+	})
+
+	Describe("ExpectErrorLogOrdered", func() {
+		It("should pass when patterns appear in order with interleaved logs", func() {
+			testlogger.ExpectErrorLogOrdered(func(logger *slog.Logger) {
+				logger.Error("Transition", "state", "connecting")
+				logger.Error("Unrelated noise")
+				logger.Error("Transition", "state", "failed")
+				logger.Error("Transition", "state", "retrying")
+			}, "state=connecting", "state=failed", "state=retrying")
+		})
+
+		It("should fail when patterns appear out of order", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogOrdered(func(logger *slog.Logger) {
+					logger.Error("Transition", "state", "failed")
+					logger.Error("Transition", "state", "connecting")
+				}, "state=connecting", "state=failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("not found in order: state=failed"))
+		})
+	})
+
+	Describe("ExpectErrorLogConsecutive", func() {
+		It("should pass when patterns match adjacent records", func() {
+			testlogger.ExpectErrorLogConsecutive(func(logger *slog.Logger) {
+				logger.Error("Connection reset")
+				logger.Error("begin tx", "id", 7)
+				logger.Error("rollback tx", "id", 7)
+				logger.Error("Retrying later")
+			}, "begin tx", "rollback tx")
+		})
+
+		It("should fail when another record is interleaved", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogConsecutive(func(logger *slog.Logger) {
+					logger.Error("begin tx")
+					logger.Error("Unrelated noise")
+					logger.Error("rollback tx")
+				}, "begin tx", "rollback tx")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("consecutive records: begin tx, rollback tx"))
+			Expect(failures[0]).To(ContainSubstring("Unrelated noise"))
+		})
+
+		It("should fail when the patterns are in the wrong order", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogConsecutive(func(logger *slog.Logger) {
+					logger.Error("rollback tx")
+					logger.Error("begin tx")
+				}, "begin tx", "rollback tx")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("ExpectErrorLogTimes", func() {
+		It("should pass when the pattern matches the expected number of records", func() {
+			testlogger.ExpectErrorLogTimes(func(logger *slog.Logger) {
+				for i := 1; i <= 3; i++ {
+					logger.Error("Request failed, retrying", "attempt", i)
+				}
+				logger.Error("Giving up")
+			}, "retrying", 3)
+		})
+
+		It("should report the actual count on mismatch", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectErrorLogTimes(func(logger *slog.Logger) {
+					logger.Error("Request failed, retrying")
+				}, "retrying", 2)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to match 2 time(s) but matched 1"))
+		})
+	})
+
+	Describe("ExpectExactLogs", func() {
+		It("should match captured logs line for line", func() {
+			testlogger.ExpectExactLogs(func(logger *slog.Logger) {
+				logger.Error("Validation failed", "field", "email")
+				logger.Error("Request rejected")
+			}, `level=ERROR msg="Validation failed" field=email`, `level=ERROR msg="Request rejected"`)
+		})
+
+		It("should fail on unexpected extra logs", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectExactLogs(func(logger *slog.Logger) {
+					logger.Error("Validation failed")
+					logger.Error("Stray log")
+				}, `level=ERROR msg="Validation failed"`)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("did not exactly match"))
+		})
+
+		It("should pass when no logs are expected or produced", func() {
+			testlogger.ExpectExactLogs(func(logger *slog.Logger) {})
+		})
+	})
+
+	Describe("ExpectLog", func() {
+		It("should validate patterns at their expected levels", func() {
+			testlogger.ExpectLog(func(logger *slog.Logger) {
+				logger.Warn("Primary unavailable")
+				logger.Error("Fallback failed")
+			},
+				testlogger.LogExpectation{Level: slog.LevelWarn, Pattern: "Primary unavailable"},
+				testlogger.LogExpectation{Level: slog.LevelError, Pattern: "Fallback failed"},
+			)
+		})
+
+		It("should fail when a pattern appears only at a different level", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectLog(func(logger *slog.Logger) {
+					logger.Warn("Database connection failed")
+				}, testlogger.LogExpectation{Level: slog.LevelError, Pattern: "Database connection failed"})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected ERROR log pattern not found: Database connection failed"))
+		})
+	})
+
+	Describe("ExpectWarnLog", func() {
+		It("should validate and hide expected WARN logs", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectWarnLog(func(logger *slog.Logger) {
+				logger.Warn("Deprecated endpoint called", "endpoint", "/v1/data")
+				logger.Error("Deprecated endpoint failed")
+			}, "Deprecated endpoint")
+
+			Expect(buf.String()).NotTo(ContainSubstring("Deprecated endpoint called"))
+			Expect(buf.String()).To(ContainSubstring("Deprecated endpoint failed"))
+		})
+
+		It("should fail when the pattern is only logged as an ERROR", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.ExpectWarnLog(func(logger *slog.Logger) {
+					logger.Error("Falling back to cache")
+				}, "Falling back")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("ExpectLogAtLevel", func() {
+		It("should validate WARN logs suppressed by the default level", func() {
+			testlogger.ExpectLogAtLevel(slog.LevelWarn, func(logger *slog.Logger) {
+				logger.Warn("Deprecated endpoint called", "endpoint", "/v1/data")
+			}, "level=WARN", "Deprecated endpoint called")
+		})
+
+		It("should validate INFO logs", func() {
+			testlogger.ExpectLogAtLevel(slog.LevelInfo, func(logger *slog.Logger) {
+				logger.Info("Audit entry recorded", "user", "abc123")
+			}, "Audit entry recorded", "user=abc123")
+		})
+	})
+
+	Describe("WithCapturedLogger", func() {
+		It("should allow manual validation of log output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			// Perform operations that generate logs
+			logger.Debug("Starting process")
+			logger.Info("Processing item", "id", "123")
+			logger.Warn("Retry attempt", "attempt", 1)
+			logger.Error("Failed to complete", "reason", "timeout")
+
+			// Validate the captured output
+			Expect(buffer).To(gbytes.Say("Starting process"))
+			Expect(buffer).To(gbytes.Say("Processing item"))
+			Expect(buffer).To(gbytes.Say("id=123"))
+			Expect(buffer).To(gbytes.Say("Retry attempt"))
+			Expect(buffer).To(gbytes.Say("Failed to complete"))
+			Expect(buffer).To(gbytes.Say("reason=timeout"))
+		})
+
+		It("should respect log level filtering", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelWarn)
+
+			logger.Debug("This won't appear")
+			logger.Info("Neither will this")
+			logger.Warn("This will appear")
+			logger.Error("And so will this")
+
+			contents := string(buffer.Contents())
+			Expect(contents).NotTo(ContainSubstring("This won't appear"))
+			Expect(contents).NotTo(ContainSubstring("Neither will this"))
+			Expect(contents).To(ContainSubstring("This will appear"))
+			Expect(contents).To(ContainSubstring("And so will this"))
+		})
+	})
+
+	Describe("WithCapturedLoggerDefault", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+		})
+
+		It("should capture at the level from LOG_LEVEL", func() {
+			os.Setenv("LOG_LEVEL", "WARN")
+			logger, buffer := testlogger.WithCapturedLoggerDefault()
+
+			logger.Info("Filtered out")
+			logger.Warn("Captured warning")
+
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("Filtered out"))
+			Expect(buffer).To(gbytes.Say("Captured warning"))
+		})
+
+		It("should capture only ERROR by default", func() {
+			logger, buffer := testlogger.WithCapturedLoggerDefault()
+
+			logger.Warn("Filtered out")
+			logger.Error("Captured error")
+
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("Filtered out"))
+			Expect(buffer).To(gbytes.Say("Captured error"))
+		})
+	})
+
+	Describe("WithCapturedLoggerOpts", func() {
+		It("should include source location when AddSource is set", func() {
+			logger, buffer := testlogger.WithCapturedLoggerOpts(&slog.HandlerOptions{
+				Level:     slog.LevelInfo,
+				AddSource: true,
+			})
+
+			logger.Info("Located log")
+
+			Expect(buffer).To(gbytes.Say(`source=\S+logger_test.go:\d+ msg="Located log"`))
+		})
+
+		It("should apply a custom ReplaceAttr", func() {
+			logger, buffer := testlogger.WithCapturedLoggerOpts(&slog.HandlerOptions{
+				Level: slog.LevelInfo,
+				ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.MessageKey {
+						a.Key = "message"
+					}
+					return a
+				},
+			})
+
+			logger.Info("Renamed")
+
+			Expect(buffer).To(gbytes.Say("message=Renamed"))
+		})
+	})
+
+	Describe("WithCapturedLoggerTee", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+		})
+
+		It("should capture logs and forward them to stderr", func() {
+			var buffer *gbytes.Buffer
+			output := testlogger.CaptureStderr(func() {
+				var logger *slog.Logger
+				logger, buffer = testlogger.WithCapturedLoggerTee(slog.LevelInfo)
+				logger.With("service", "auth").Info("Teed log")
+			})
+
+			Expect(buffer).To(gbytes.Say(`msg="Teed log" service=auth`))
+			Expect(output).To(ContainSubstring(`msg="Teed log" service=auth`))
+		})
+
+		It("should apply LOG_LEVEL to the stderr copy only", func() {
+			os.Setenv("LOG_LEVEL", "ERROR")
+			var buffer *gbytes.Buffer
+			output := testlogger.CaptureStderr(func() {
+				var logger *slog.Logger
+				logger, buffer = testlogger.WithCapturedLoggerTee(slog.LevelDebug)
+				logger.Debug("Debug detail")
+				logger.Error("Failure")
+			})
+
+			testlogger.AssertLogContains(buffer, "Debug detail", "Failure")
+			Expect(output).NotTo(ContainSubstring("Debug detail"))
+			Expect(output).To(ContainSubstring("Failure"))
+		})
+	})
+
+	Describe("WithCapturedContextLogger", func() {
+		type requestIDKey struct{}
+
+		extractRequestID := func(ctx context.Context) []slog.Attr {
+			if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+				return []slog.Attr{slog.String("request_id", id)}
+			}
+			return nil
+		}
+
+		It("should attach attributes extracted from the context", func() {
+			logger, buffer := testlogger.WithCapturedContextLogger(slog.LevelInfo, extractRequestID)
+			ctx := context.WithValue(context.Background(), requestIDKey{}, "abc123")
+
+			logger.With("service", "api").InfoContext(ctx, "Handled request")
+			logger.Info("No context values")
+
+			Expect(buffer).To(gbytes.Say(`msg="Handled request" service=api request_id=abc123`))
+			Expect(buffer).To(gbytes.Say(`msg="No context values"\n`))
+		})
+	})
+
+	Describe("WithCapturedLoggerGID", func() {
+		It("should tag records with the goroutine that logged them", func() {
+			logger, buffer := testlogger.WithCapturedLoggerGID(slog.LevelInfo)
+
+			logger.Info("Main", "step", 1)
+			logger.With("component", "worker").Info("Main", "step", 2)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				logger.Info("Worker")
+			}()
+			<-done
+
+			entries, err := testlogger.Entries(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(3))
+			for _, entry := range entries {
+				Expect(entry.Attrs).To(HaveKeyWithValue("goroutine", MatchRegexp(`^[1-9]\d*$`)))
+			}
+			Expect(entries[1].Attrs["goroutine"]).To(Equal(entries[0].Attrs["goroutine"]))
+			Expect(entries[2].Attrs["goroutine"]).NotTo(Equal(entries[0].Attrs["goroutine"]))
+		})
+	})
+
+	Describe("WithCapturedStdLogger", func() {
+		It("should capture standard library log output as records", func() {
+			logger, buffer := testlogger.WithCapturedStdLogger(slog.LevelWarn)
+
+			logger.Printf("legacy handshake error: %s", "EOF")
+
+			Expect(buffer).To(gbytes.Say(`level=WARN msg="legacy handshake error: EOF"`))
+			testlogger.AssertLogLevelCount(buffer, slog.LevelWarn, 1)
+		})
+	})
+
+	Describe("WithCapturedLoggerWriter", func() {
+		It("should write logs to the supplied writer", func() {
+			var output bytes.Buffer
+			logger := testlogger.WithCapturedLoggerWriter(&output, slog.LevelInfo)
+
+			logger.Debug("Filtered out")
+			logger.Info("Written to caller", "id", 7)
+
+			Expect(output.String()).To(ContainSubstring(`msg="Written to caller" id=7`))
+			Expect(output.String()).NotTo(ContainSubstring("Filtered out"))
+		})
+	})
+
+	Describe("WithCapturedLoggerFile", func() {
+		It("should write logs to a temporary file removed by cleanup", func() {
+			logger, path, cleanup := testlogger.WithCapturedLoggerFile(slog.LevelInfo)
+
+			logger.Debug("Filtered out")
+			logger.Info("Written to file", "id", 7)
+
+			contents, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`msg="Written to file" id=7`))
+			Expect(string(contents)).NotTo(ContainSubstring("Filtered out"))
+
+			cleanup()
+			_, err = os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("WithCapturedLoggerClock", func() {
+		It("should stamp records using the supplied clock", func() {
+			current := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			logger, buffer := testlogger.WithCapturedLoggerClock(slog.LevelInfo, func() time.Time {
+				current = current.Add(time.Second)
+				return current
+			})
+
+			logger.Info("First tick")
+			logger.With("id", 1).Info("Second tick")
+
+			Expect(string(buffer.Contents())).To(Equal(
+				"time=2025-01-01T00:00:01.000Z level=INFO msg=\"First tick\"\n" +
+					"time=2025-01-01T00:00:02.000Z level=INFO msg=\"Second tick\" id=1\n"))
+		})
+	})
+
+	Describe("WithCountingLogger", func() {
+		It("should count records at or above the level", func() {
+			logger, count := testlogger.WithCountingLogger(slog.LevelInfo)
+
+			logger.Debug("Filtered out")
+			logger.Info("Counted")
+			logger.With("component", "db").WithGroup("query").Error("Also counted", "rows", 0)
+
+			Expect(atomic.LoadInt64(count)).To(Equal(int64(2)))
+		})
+
+		It("should count concurrent records", func() {
+			logger, count := testlogger.WithCountingLogger(slog.LevelInfo)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 100; j++ {
+						logger.Info("Worker log", "iteration", j)
+					}
+				}()
+			}
+			wg.Wait()
+
+			Expect(atomic.LoadInt64(count)).To(Equal(int64(1000)))
+		})
+	})
+
+	Describe("WithFailOnErrorLogger", func() {
+		It("should not fail for records below ERROR", func() {
+			logger := testlogger.WithFailOnErrorLogger()
+
+			logger.Warn("Slow response", "duration_ms", 900)
+		})
+
+		It("should fail with the record and its call site on ERROR", func() {
+			logger := testlogger.WithFailOnErrorLogger().With("service", "auth")
+
+			failures := InterceptGomegaFailures(func() {
+				logger.Error("Token validation failed", "user", "alice")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(MatchRegexp(
+				`^Unexpected ERROR log at \S+logger_test\.go:\d+: level=ERROR msg="Token validation failed" service=auth user=alice$`))
+		})
+
+		It("should tolerate ERROR records matching an allowed pattern", func() {
+			logger := testlogger.WithFailOnErrorLogger("Cache miss", `attempt=\d+`)
+
+			failures := InterceptGomegaFailures(func() {
+				logger.Error("Cache miss", "key", "user:1")
+				logger.Error("Retrying", "attempt", 2)
+				logger.Error("Database unavailable")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`msg="Database unavailable"`))
+		})
+
+		It("should match allowed patterns against attributes added with With", func() {
+			logger := testlogger.WithFailOnErrorLogger("component=cache").With("component", "cache")
+
+			failures := InterceptGomegaFailures(func() {
+				logger.Error("Cache miss storm")
+				logger.WithGroup("request").Error("Cache miss storm", "id", 7)
+			})
+			Expect(failures).To(BeEmpty())
+
+			failures = InterceptGomegaFailures(func() {
+				testlogger.WithFailOnErrorLogger().WithGroup("request").With("id", 7).Error("Lookup failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`msg="Lookup failed" request.id=7`))
+		})
+
+		It("should fail on ERROR even when LOG_LEVEL is OFF", func() {
+			os.Setenv("LOG_LEVEL", "OFF")
+			DeferCleanup(os.Unsetenv, "LOG_LEVEL")
+			logger := testlogger.WithFailOnErrorLogger()
+
+			failures := InterceptGomegaFailures(func() {
+				logger.Error("Silenced but still checked")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("WithCapturedJSONLogger", func() {
+		It("should capture JSON formatted logs", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("User action",
+				"user_id", "abc123",
+				"action", "login",
+				"success", true)
+
+			// Validate JSON structure
+			Expect(buffer).To(gbytes.Say(`"level":"INFO"`))
+			Expect(buffer).To(gbytes.Say(`"msg":"User action"`))
+			Expect(buffer).To(gbytes.Say(`"user_id":"abc123"`))
+			Expect(buffer).To(gbytes.Say(`"action":"login"`))
+			Expect(buffer).To(gbytes.Say(`"success":true`))
+		})
+	})
+
+	Describe("WithCapturedLoggerStable", func() {
+		It("should omit the time field from text output", func() {
+			logger, buffer := testlogger.WithCapturedLoggerStable(slog.LevelInfo)
+
+			logger.Info("Stable output", "count", 3)
+			logger.WithGroup("req").Info("Grouped", "time", "kept")
+
+			Expect(string(buffer.Contents())).To(Equal(
+				"level=INFO msg=\"Stable output\" count=3\n" +
+					"level=INFO msg=Grouped req.time=kept\n"))
+		})
+	})
+
+	Describe("WithCapturedJSONLoggerStable", func() {
+		It("should set the time field to a fixed value in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLoggerStable(slog.LevelInfo)
+
+			logger.Info("Stable output", "count", 3)
+
+			Expect(string(buffer.Contents())).To(Equal(
+				`{"time":"0001-01-01T00:00:00Z","level":"INFO","msg":"Stable output","count":3}` + "\n"))
+		})
+	})
+
+	Describe("Snapshot", func() {
+		It("should include content already consumed by gbytes.Say", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("First record")
+			logger.Info("Second record")
+			Expect(buffer).To(gbytes.Say("Second record"))
+
+			snapshot, err := io.ReadAll(testlogger.Snapshot(buffer))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(snapshot), "\n")).To(Equal(2))
+			Expect(string(snapshot)).To(ContainSubstring("First record"))
+		})
+
+		It("should not change when more logs are written", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Before snapshot")
+			snapshot := testlogger.Snapshot(buffer)
+			logger.Info("After snapshot")
+
+			contents, err := io.ReadAll(snapshot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).NotTo(ContainSubstring("After snapshot"))
+			Expect(buffer).To(gbytes.Say("Before snapshot"))
+		})
+	})
+
+	Describe("ResetBuffer", func() {
+		It("should discard contents and rewind the read cursor", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("First iteration")
+			Expect(buffer).To(gbytes.Say("First iteration"))
+
+			testlogger.ResetBuffer(buffer)
+			logger.Info("Second iteration")
+
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("First iteration"))
+			Expect(buffer).To(gbytes.Say("Second iteration"))
+		})
+	})
+
+	Describe("PollableBuffer", func() {
+		It("should report patterns logged asynchronously", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			pollable := testlogger.NewPollableBuffer(buffer)
+
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				logger.Info("Worker done", "jobs", 3)
+			}()
+
+			Eventually(func() bool { return pollable.Contains(`done" jobs=\d+`) }).Should(BeTrue())
+		})
+
+		It("should ignore the read cursor", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			pollable := testlogger.NewPollableBuffer(buffer)
+
+			logger.Info("Worker done")
+			Expect(pollable).To(gbytes.Say("Worker done"))
+
+			Expect(pollable.Contains("Worker done")).To(BeTrue())
+			Expect(pollable.Contains("Worker done")).To(BeTrue())
+			Expect(pollable.Contains("Worker failed")).To(BeFalse())
+		})
+	})
+
+	Describe("AssertLogContains", func() {
+		It("should match patterns in any order on a drained buffer", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Processing started")
+			logger.Info("Processing finished", "count", 42)
+			Expect(buffer).To(gbytes.Say("Processing finished"))
+
+			testlogger.AssertLogContains(buffer, `count=\d+`, "Processing started")
+		})
+
+		It("should name the missing pattern on failure", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Processing started")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogContains(buffer, "Processing started", "Processing finished")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected log pattern not found: Processing finished"))
+		})
+	})
+
+	Describe("AssertLogContainsAll", func() {
+		It("should pass when every pattern matches in any order", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Listener ready")
+			logger.Info("Cache warmed", "entries", 42)
+			Expect(buffer).To(gbytes.Say("Cache warmed"))
+
+			testlogger.AssertLogContainsAll(buffer, `entries=\d+`, "Listener ready")
+		})
+
+		It("should list every missing pattern", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Listener ready")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogContainsAll(buffer, "Cache warmed", "Listener ready", "Metrics enabled")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`Expected log patterns not found: ["Cache warmed" "Metrics enabled"]`))
+		})
+	})
+
+	Describe("AssertLogContainsAny", func() {
+		It("should pass when one pattern matches", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected via IPv6")
+
+			testlogger.AssertLogContainsAny(buffer, "Connected via IPv4", "Connected via IPv6")
+		})
+
+		It("should list the patterns when none match", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connection refused")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogContainsAny(buffer, "Connected via IPv4", "Connected via IPv6")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`None of the expected log patterns were found: ["Connected via IPv4" "Connected via IPv6"]`))
+		})
+	})
+
+	Describe("AssertLogSequence", func() {
+		It("should pass when patterns match records in order", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Order received", "id", 7)
+			logger.Info("Inventory checked")
+			logger.Info("Payment captured")
+			logger.Info("Order shipped")
+
+			testlogger.AssertLogSequence(buffer, []string{"Order received", "Payment captured", "Order shipped"})
+		})
+
+		It("should require each pattern to match a later record", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Retry", "attempt", 1)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogSequence(buffer, []string{"Retry", "Retry"})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Log sequence broken at pattern 1: Retry"))
+		})
+
+		It("should report the first pattern matched out of order", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Order shipped")
+			logger.Info("Order received")
+			logger.Info("Payment captured")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogSequence(buffer, []string{"Order received", "Order shipped", "Payment captured"})
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Log sequence broken at pattern 1: Order shipped"))
+		})
+	})
+
+	Describe("AssertLogNotContains", func() {
+		It("should pass when no forbidden pattern appears", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("User logged in", "user", "abc123")
+
+			testlogger.AssertLogNotContains(buffer, "hunter2", `token=\S+`)
+		})
+
+		It("should name the forbidden pattern on failure", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("User logged in", "token", "secret-token")
+			Expect(buffer).To(gbytes.Say("User logged in"))
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogNotContains(buffer, "hunter2", `token=\S+`)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`Forbidden log pattern found: token=\S+`))
+		})
+	})
+
+	Describe("AssertNoLogs", func() {
+		It("should pass when nothing but whitespace was logged", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Debug("Below the captured level")
+			buffer.Write([]byte("\n"))
+
+			testlogger.AssertNoLogs(buffer)
+		})
+
+		It("should show the unexpected output on failure", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Chatty fast path")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertNoLogs(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected no logs but found"))
+			Expect(failures[0]).To(ContainSubstring("Chatty fast path"))
+		})
+	})
+
+	Describe("AssertNoErrorLogs", func() {
+		It("should pass when no ERROR logs are present", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Debug("Debug message")
+			logger.Info("Info message")
+			logger.Warn("Warning message")
 
-			// Run test with multiple logs - only validate one pattern
-			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
-				logger.Error("Test expected: should be hidden")
-				logger.Error("Test unexpected: should be visible")
-			}, "Test expected")
+			testlogger.AssertNoErrorLogs(buffer)
+		})
+	})
 
-			// Restore stderr and read captured output
-			w.Close()
-			os.Stderr = originalStderr
-			var buf bytes.Buffer
-			buf.ReadFrom(r)
-			stderrOutput := buf.String()
+	Describe("WithCapturedProblemLogger and AssertNoProblems", func() {
+		It("should capture only warnings and errors", func() {
+			logger, buffer := testlogger.WithCapturedProblemLogger()
 
-			// Expected log should be hidden (NOT in stderr)
-			Expect(stderrOutput).NotTo(ContainSubstring("Test expected: should be hidden"))
+			logger.Debug("Debug noise")
+			logger.Info("Info noise")
+			logger.Warn("Retrying")
+			logger.Error("Giving up")
 
-			// Unexpected log should be visible (IN stderr)
-			Expect(stderrOutput).To(ContainSubstring("Test unexpected: should be visible"))
+			contents := string(buffer.Contents())
+			Expect(contents).NotTo(ContainSubstring("noise"))
+			Expect(buffer).To(gbytes.Say(`level=WARN msg=Retrying`))
+			Expect(buffer).To(gbytes.Say(`level=ERROR msg="Giving up"`))
 		})
-	})
 
-	Describe("ExpectErrorLogJSON", func() {
-		It("should validate JSON formatted error logs", func() {
-			testlogger.ExpectErrorLogJSON(func(logger *slog.Logger) {
-				logger.Error("Database connection failed",
-					"host", "localhost",
-					"port", 5432,
-					"error", errors.New("connection refused"))
-			}, `"level":"ERROR"`, `"msg":"Database connection failed"`, `"host":"localhost"`, `"port":5432`)
+		It("should pass when only lower levels were logged", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("WARN and ERROR mentioned in a message")
+
+			testlogger.AssertNoProblems(buffer)
+		})
+
+		It("should report warnings and errors", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Warn("Retrying")
+			logger.Log(context.Background(), slog.LevelError+4, "Fatal")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertNoProblems(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`"msg":"Retrying"`))
+			Expect(failures[0]).To(ContainSubstring(`"msg":"Fatal"`))
 		})
 	})
 
-	Describe("WithCapturedLogger", func() {
-		It("should allow manual validation of log output", func() {
+	Describe("AssertNoWarnLogs", func() {
+		It("should pass when no WARN logs are present", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Info("Info message")
+			logger.Info("Message mentioning level=WARN and WARN in its body")
+			logger.Error("Error message")
+
+			testlogger.AssertNoWarnLogs(buffer)
+		})
+
+		It("should fail when a WARN log is present", func() {
 			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
 
-			// Perform operations that generate logs
-			logger.Debug("Starting process")
-			logger.Info("Processing item", "id", "123")
-			logger.Warn("Retry attempt", "attempt", 1)
-			logger.Error("Failed to complete", "reason", "timeout")
+			logger.Info("Info message")
+			logger.Warn("Disk almost full")
 
-			// Validate the captured output
-			Expect(buffer).To(gbytes.Say("Starting process"))
-			Expect(buffer).To(gbytes.Say("Processing item"))
-			Expect(buffer).To(gbytes.Say("id=123"))
-			Expect(buffer).To(gbytes.Say("Retry attempt"))
-			Expect(buffer).To(gbytes.Say("Failed to complete"))
-			Expect(buffer).To(gbytes.Say("reason=timeout"))
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertNoWarnLogs(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Disk almost full"))
 		})
+	})
 
-		It("should respect log level filtering", func() {
-			logger, buffer := testlogger.WithCapturedLogger(slog.LevelWarn)
+	Describe("WaitForLogs", func() {
+		It("should wait for records logged by background goroutines", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
 
-			logger.Debug("This won't appear")
-			logger.Info("Neither will this")
-			logger.Warn("This will appear")
-			logger.Error("And so will this")
+			for i := 1; i <= 3; i++ {
+				go func() {
+					time.Sleep(time.Duration(i*10) * time.Millisecond)
+					logger.Info("Notification sent", "id", i)
+				}()
+			}
 
-			contents := string(buffer.Contents())
-			Expect(contents).NotTo(ContainSubstring("This won't appear"))
-			Expect(contents).NotTo(ContainSubstring("Neither will this"))
-			Expect(contents).To(ContainSubstring("This will appear"))
-			Expect(contents).To(ContainSubstring("And so will this"))
+			testlogger.WaitForLogs(buffer, 3, time.Second)
+			testlogger.AssertLogLevelCount(buffer, slog.LevelInfo, 3)
+		})
+
+		It("should fail when too few records arrive in time", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Notification sent", "id", 1)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.WaitForLogs(buffer, 2, 50*time.Millisecond)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected at least 2 log records within 50ms"))
 		})
 	})
 
-	Describe("WithCapturedJSONLogger", func() {
-		It("should capture JSON formatted logs", func() {
-			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+	Describe("AssertMaxLogLines", func() {
+		It("should count multi-line records once", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
 
-			logger.Info("User action",
-				"user_id", "abc123",
-				"action", "login",
-				"success", true)
+			logger.Info("Batch started")
+			buffer.Write([]byte("level=ERROR msg=\"Stack trace\"\n  at main.go:12\n  at main.go:30\n"))
 
-			// Validate JSON structure
-			Expect(buffer).To(gbytes.Say(`"level":"INFO"`))
-			Expect(buffer).To(gbytes.Say(`"msg":"User action"`))
-			Expect(buffer).To(gbytes.Say(`"user_id":"abc123"`))
-			Expect(buffer).To(gbytes.Say(`"action":"login"`))
-			Expect(buffer).To(gbytes.Say(`"success":true`))
+			testlogger.AssertMaxLogLines(buffer, 2)
+		})
+
+		It("should fail when too many records are logged", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			for i := range 3 {
+				logger.Info("Processing item", "index", i)
+			}
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertMaxLogLines(buffer, 2)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected at most 2 log records but found 3"))
 		})
 	})
 
-	Describe("AssertNoErrorLogs", func() {
-		It("should pass when no ERROR logs are present", func() {
+	Describe("AssertLogLevelCount", func() {
+		It("should count entries at the given level", func() {
 			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
 
-			logger.Debug("Debug message")
-			logger.Info("Info message")
-			logger.Warn("Warning message")
+			for i := 1; i <= 3; i++ {
+				logger.Warn("Retrying request", "attempt", i)
+			}
+			logger.Error("Giving up")
+			logger.Info("Message mentioning level=ERROR")
 
-			testlogger.AssertNoErrorLogs(buffer)
+			testlogger.AssertLogLevelCount(buffer, slog.LevelWarn, 3)
+			testlogger.AssertLogLevelCount(buffer, slog.LevelError, 1)
+			testlogger.AssertLogLevelCount(buffer, slog.LevelDebug, 0)
+		})
+
+		It("should count JSON entries and report both counts on failure", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Warn("Retrying request")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogLevelCount(buffer, slog.LevelWarn, 2)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected 2 WARN log(s) but found 1"))
+		})
+
+		It("should count entries at LevelOff", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Log(context.Background(), testlogger.LevelOff, "Off the scale")
+
+			testlogger.AssertLogLevelCount(buffer, testlogger.LevelOff, 1)
+		})
+
+		It("should count a multi-line entry once", func() {
+			buffer := gbytes.BufferWithBytes([]byte(
+				"level=ERROR msg=panic\ngoroutine 1 [running]:\nmain.main()\nlevel=INFO msg=recovered\n"))
+
+			testlogger.AssertLogLevelCount(buffer, slog.LevelError, 1)
+			testlogger.AssertLogLevelCount(buffer, slog.LevelInfo, 1)
 		})
 	})
 
@@ -198,7 +1564,7 @@ var _ = Describe("Logger Test Utilities", func() {
 		})
 
 		It("should work with concurrent logging", func() {
-			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+			output := testlogger.ExpectErrorLogCapture(func(logger *slog.Logger) {
 				var wg sync.WaitGroup
 				for i := 0; i < 10; i++ {
 					wg.Add(1)
@@ -209,6 +1575,23 @@ var _ = Describe("Logger Test Utilities", func() {
 				}
 				wg.Wait()
 			}, "Concurrent log")
+
+			testlogger.AssertAttrValuesExactly(gbytes.BufferWithBytes([]byte(output)),
+				"goroutine", 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+		})
+
+		It("should capture heavy concurrent logging without losing records", func() {
+			testlogger.ExpectErrorLogTimes(func(logger *slog.Logger) {
+				var wg sync.WaitGroup
+				for i := 0; i < 100; i++ {
+					wg.Add(1)
+					go func(id int) {
+						defer wg.Done()
+						logger.With("worker", id).Error("Concurrent failure", "goroutine", id)
+					}(i)
+				}
+				wg.Wait()
+			}, "Concurrent failure", 100)
 		})
 
 		It("should handle logs with newlines", func() {
@@ -217,6 +1600,20 @@ var _ = Describe("Logger Test Utilities", func() {
 			}, "Multi-line error message")
 		})
 
+		It("should hide every line of a matching multi-line log", func() {
+			var buf bytes.Buffer
+			testlogger.SetUnexpectedLogWriter(&buf)
+			DeferCleanup(func() { testlogger.SetUnexpectedLogWriter(nil) })
+
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Multi-line error message\nwith details on second line")
+				logger.Error("Unrelated error")
+			}, "Multi-line error message")
+
+			Expect(buf.String()).NotTo(ContainSubstring("with details on second line"))
+			Expect(buf.String()).To(ContainSubstring("Unrelated error"))
+		})
+
 		It("should handle unicode characters", func() {
 			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
 				logger.Error("Error processing: 日本語 文字")
@@ -226,8 +1623,10 @@ var _ = Describe("Logger Test Utilities", func() {
 
 	Describe("ConfigureTestLogging", func() {
 		AfterEach(func() {
-			// Clean up environment variable after each test
+			// Clean up environment variables and default logger after each test
 			os.Unsetenv("LOG_LEVEL")
+			os.Unsetenv("LOG_FORMAT")
+			testlogger.RestoreDefaultLogger()
 		})
 
 		It("should set default logger without panicking", func() {
@@ -238,6 +1637,65 @@ var _ = Describe("Logger Test Utilities", func() {
 			}).NotTo(Panic())
 		})
 
+		It("should return the logger installed as the default", func() {
+			logger := testlogger.ConfigureTestLogging()
+
+			Expect(logger).To(BeIdenticalTo(slog.Default()))
+		})
+
+		It("should install a JSON handler when LOG_FORMAT is json", func() {
+			os.Setenv("LOG_FORMAT", "JSON")
+			os.Setenv("LOG_LEVEL", "INFO")
+			output := testlogger.CaptureStderr(func() {
+				logger := testlogger.ConfigureTestLogging()
+				logger.Info("Suite started", "specs", 3)
+			})
+
+			Expect(output).To(MatchRegexp(`"level":"INFO","msg":"Suite started","specs":3\}`))
+		})
+
+		It("should hide bridged standard log output at the default level", func() {
+			output := testlogger.CaptureStderr(func() {
+				testlogger.ConfigureTestLogging()
+				log.Print("Legacy dependency notice")
+			})
+
+			Expect(output).To(BeEmpty())
+		})
+
+		It("should hide bridged standard log output when OFF", func() {
+			os.Setenv("LOG_LEVEL", "OFF")
+
+			output := testlogger.CaptureStderr(func() {
+				testlogger.ConfigureTestLogging()
+				log.Print("Legacy dependency notice")
+			})
+
+			Expect(output).To(BeEmpty())
+		})
+
+		It("should show bridged standard log output at INFO", func() {
+			os.Setenv("LOG_LEVEL", "INFO")
+
+			output := testlogger.CaptureStderr(func() {
+				testlogger.ConfigureTestLogging()
+				log.Print("Legacy dependency notice")
+			})
+
+			Expect(output).To(ContainSubstring(`level=INFO msg="Legacy dependency notice"`))
+		})
+
+		It("should restore the previous standard log bridge level", func() {
+			previous := slog.SetLogLoggerLevel(slog.LevelWarn)
+			DeferCleanup(func() { slog.SetLogLoggerLevel(previous) })
+
+			testlogger.ConfigureTestLogging()
+			Expect(slog.SetLogLoggerLevel(slog.LevelInfo)).To(Equal(slog.LevelInfo))
+
+			testlogger.RestoreDefaultLogger()
+			Expect(slog.SetLogLoggerLevel(slog.LevelWarn)).To(Equal(slog.LevelWarn))
+		})
+
 		It("should respect DEBUG log level from environment", func() {
 			os.Setenv("LOG_LEVEL", "DEBUG")
 			Expect(func() {
@@ -265,5 +1723,232 @@ var _ = Describe("Logger Test Utilities", func() {
 				testlogger.ConfigureTestLogging()
 			}).NotTo(Panic())
 		})
+
+		It("should suppress all logs when LOG_LEVEL is OFF or SILENT", func() {
+			for _, value := range []string{"OFF", "silent"} {
+				os.Setenv("LOG_LEVEL", value)
+				testlogger.ConfigureTestLogging()
+
+				Expect(slog.Default().Enabled(context.Background(), slog.LevelError)).To(BeFalse())
+			}
+		})
+
+		It("should still capture expected errors when LOG_LEVEL is OFF", func() {
+			os.Setenv("LOG_LEVEL", "OFF")
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Error("Deliberate failure")
+			}, "Deliberate failure")
+		})
+
+		It("should respect TRACE log level from environment", func() {
+			os.Setenv("LOG_LEVEL", "TRACE")
+			testlogger.ConfigureTestLogging()
+
+			Expect(slog.Default().Enabled(context.Background(), testlogger.LevelTrace)).To(BeTrue())
+		})
+	})
+
+	Describe("LOG_LEVEL parsing", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+			testlogger.RestoreDefaultLogger()
+		})
+
+		It("should accept lowercase level names with whitespace", func() {
+			os.Setenv("LOG_LEVEL", " info ")
+			testlogger.ExpectLogAtLevel(slog.LevelError, func(logger *slog.Logger) {
+				logger.Info("Visible at info")
+			}, "Visible at info")
+		})
+
+		It("should accept numeric levels", func() {
+			os.Setenv("LOG_LEVEL", "4")
+			testlogger.ConfigureTestLogging()
+
+			Expect(slog.Default().Enabled(context.Background(), slog.LevelInfo)).To(BeFalse())
+			Expect(slog.Default().Enabled(context.Background(), slog.LevelWarn)).To(BeTrue())
+		})
+	})
+
+	Describe("Custom numeric levels", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+		})
+
+		It("should capture levels below DEBUG", func() {
+			os.Setenv("LOG_LEVEL", "-8")
+			testlogger.ExpectErrorLog(func(logger *slog.Logger) {
+				logger.Log(context.Background(), slog.Level(-8), "Trace message")
+			}, "level=DEBUG-4", "Trace message")
+		})
+	})
+
+	Describe("RestoreDefaultLogger", func() {
+		It("should reinstate the logger replaced by ConfigureTestLogging", func() {
+			original := slog.Default()
+
+			testlogger.ConfigureTestLogging()
+			testlogger.ConfigureTestLogging()
+			Expect(slog.Default()).NotTo(BeIdenticalTo(original))
+
+			testlogger.RestoreDefaultLogger()
+			Expect(slog.Default()).To(BeIdenticalTo(original))
+		})
+
+		It("should be a no-op when logging was not configured", func() {
+			original := slog.Default()
+
+			testlogger.RestoreDefaultLogger()
+			Expect(slog.Default()).To(BeIdenticalTo(original))
+		})
+	})
+
+	Describe("CaptureDefaultLogs", func() {
+		It("should capture logs written through the default logger", func() {
+			original := slog.Default()
+
+			buffer, restore := testlogger.CaptureDefaultLogs(slog.LevelInfo)
+			slog.Info("Legacy log", "id", 7)
+			log.Print("Standard library log")
+			restore()
+
+			Expect(buffer).To(gbytes.Say(`msg="Legacy log" id=7`))
+			Expect(buffer).To(gbytes.Say(`msg="Standard library log"`))
+			Expect(slog.Default()).To(BeIdenticalTo(original))
+		})
+
+		It("should restore the default logger when the test panics", func() {
+			original := slog.Default()
+
+			Expect(func() {
+				_, restore := testlogger.CaptureDefaultLogs(slog.LevelInfo)
+				defer restore()
+				panic("boom")
+			}).To(Panic())
+
+			Expect(slog.Default()).To(BeIdenticalTo(original))
+		})
+	})
+
+	Describe("ConfigureTestLoggingCaptured", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+		})
+
+		It("should capture default logger output until restored", func() {
+			os.Setenv("LOG_LEVEL", "INFO")
+			original := slog.Default()
+
+			buffer, restore := testlogger.ConfigureTestLoggingCaptured()
+			slog.Debug("Filtered out")
+			slog.Info("Suite event", "spec", 1)
+			restore()
+			slog.Info("After restore")
+
+			Expect(slog.Default()).To(BeIdenticalTo(original))
+			Expect(buffer).To(gbytes.Say(`msg="Suite event" spec=1`))
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("Filtered out"))
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("After restore"))
+		})
+
+		It("should filter bridged standard log output as INFO", func() {
+			hidden, restore := testlogger.ConfigureTestLoggingCaptured()
+			log.Print("Hidden legacy notice")
+			restore()
+
+			os.Setenv("LOG_LEVEL", "INFO")
+			shown, restore := testlogger.ConfigureTestLoggingCaptured()
+			log.Print("Shown legacy notice")
+			restore()
+
+			Expect(hidden.Contents()).To(BeEmpty())
+			Expect(shown).To(gbytes.Say(`level=INFO msg="Shown legacy notice"`))
+		})
+	})
+
+	Describe("ConfigureTestLoggingOnce", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+			testlogger.RestoreDefaultLogger()
+		})
+
+		It("should keep the first configuration", func() {
+			os.Setenv("LOG_LEVEL", "WARN")
+			first := testlogger.ConfigureTestLoggingOnce()
+			Expect(slog.Default()).To(BeIdenticalTo(first))
+
+			os.Setenv("LOG_LEVEL", "DEBUG")
+			second := testlogger.ConfigureTestLoggingOnce()
+
+			Expect(second).To(BeIdenticalTo(first))
+			Expect(slog.Default()).To(BeIdenticalTo(first))
+			Expect(first.Enabled(context.Background(), slog.LevelInfo)).To(BeFalse())
+		})
+	})
+
+	Describe("ConfigureTestLoggingWithOptions", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+			testlogger.RestoreDefaultLogger()
+		})
+
+		It("should apply the ReplaceAttr hook", func() {
+			os.Setenv("LOG_LEVEL", "INFO")
+
+			output := testlogger.CaptureStderr(func() {
+				testlogger.ConfigureTestLoggingWithOptions(&slog.HandlerOptions{
+					ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+						if len(groups) == 0 && a.Key == slog.MessageKey {
+							a.Key = "message"
+						}
+						if len(groups) == 0 && a.Key == slog.TimeKey {
+							return slog.Attr{}
+						}
+						return a
+					},
+				})
+				slog.Info("Configured", "id", 7)
+			})
+
+			Expect(output).To(Equal("level=INFO message=Configured id=7\n"))
+		})
+
+		It("should let an explicit level override LOG_LEVEL", func() {
+			os.Setenv("LOG_LEVEL", "DEBUG")
+
+			logger := testlogger.ConfigureTestLoggingWithOptions(&slog.HandlerOptions{Level: slog.LevelWarn})
+
+			Expect(logger.Enabled(context.Background(), slog.LevelInfo)).To(BeFalse())
+			Expect(logger.Enabled(context.Background(), slog.LevelWarn)).To(BeTrue())
+		})
+	})
+
+	Describe("ConfigureTestLoggingWithEnv", func() {
+		AfterEach(func() {
+			os.Unsetenv("LOG_LEVEL")
+			os.Unsetenv("TEST_LOG_LEVEL")
+			testlogger.RestoreDefaultLogger()
+		})
+
+		It("should read the level from the configured variable", func() {
+			os.Setenv("LOG_LEVEL", "DEBUG")
+			os.Setenv("TEST_LOG_LEVEL", "WARN")
+
+			testlogger.ConfigureTestLoggingWithEnv("TEST_LOG_LEVEL")
+
+			Expect(slog.Default().Enabled(context.Background(), slog.LevelInfo)).To(BeFalse())
+			Expect(slog.Default().Enabled(context.Background(), slog.LevelWarn)).To(BeTrue())
+		})
+
+		It("should read LOG_LEVEL again after RestoreDefaultLogger", func() {
+			os.Setenv("TEST_LOG_LEVEL", "WARN")
+			testlogger.ConfigureTestLoggingWithEnv("TEST_LOG_LEVEL")
+			testlogger.RestoreDefaultLogger()
+
+			os.Setenv("LOG_LEVEL", "DEBUG")
+			testlogger.ConfigureTestLogging()
+
+			Expect(slog.Default().Enabled(context.Background(), slog.LevelDebug)).To(BeTrue())
+		})
 	})
 })
@@ -1,56 +1,267 @@
 package testlogger
 
 import (
+	"io"
+	"log"
 	"log/slog"
 	"os"
+	"strings"
+	"sync"
+
+	"github.com/onsi/gomega/gbytes"
 )
 
+// defaultLoggerState captures the process-wide logging configuration that
+// slog.SetDefault modifies, including the standard log package output that
+// slog redirects through its handler, along with the environment variable
+// the log level is read from.
+type defaultLoggerState struct {
+	logger         *slog.Logger
+	writer         io.Writer
+	flags          int
+	logLoggerLevel slog.Level
+	logLevelEnvVar string
+}
+
+// previousDefault holds the state replaced by the first ConfigureTestLogging
+// call, so RestoreDefaultLogger can reinstate it.
+var previousDefault *defaultLoggerState
+
+func currentDefaultLoggerState() defaultLoggerState {
+	// slog offers no getter for the bridge level, so read it by swapping
+	logLoggerLevel := slog.SetLogLoggerLevel(slog.LevelInfo)
+	slog.SetLogLoggerLevel(logLoggerLevel)
+	return defaultLoggerState{
+		logger:         slog.Default(),
+		writer:         log.Writer(),
+		flags:          log.Flags(),
+		logLoggerLevel: logLoggerLevel,
+		logLevelEnvVar: logLevelEnvVar,
+	}
+}
+
+func (s defaultLoggerState) restore() {
+	slog.SetDefault(s.logger)
+	log.SetOutput(s.writer)
+	log.SetFlags(s.flags)
+	slog.SetLogLoggerLevel(s.logLoggerLevel)
+	logLevelEnvVar = s.logLevelEnvVar
+}
+
+// rememberDefaultLogger saves the current state for RestoreDefaultLogger
+// unless a previous configuration call already did.
+func rememberDefaultLogger() {
+	if previousDefault == nil {
+		state := currentDefaultLoggerState()
+		previousDefault = &state
+	}
+}
+
+// setDefaultLogger installs logger as the slog default, remembering the
+// previous state the first time it is called.
+func setDefaultLogger(logger *slog.Logger) {
+	rememberDefaultLogger()
+	slog.SetDefault(logger)
+}
+
 // ConfigureTestLogging sets up slog for test suites with sensible defaults
 // for Ginkgo/Gomega BDD testing.
 //
 // By default, suppresses INFO and WARN messages but shows ERROR for debugging.
 //
 // The LOG_LEVEL environment variable controls logging verbosity:
-//   - DEBUG: Shows all logs to stderr (most verbose)
+//   - TRACE: Shows all logs including LevelTrace to stderr (most verbose)
+//   - DEBUG: Shows DEBUG and above to stderr
 //   - INFO: Shows INFO and above to stderr
 //   - WARN: Shows WARN and above to stderr
 //   - ERROR: Shows ERROR only to stderr
+//   - OFF or SILENT: Suppresses all logs, including ERROR
 //   - (default): Shows ERROR only, suppresses INFO and WARN
 //
-// This should be called in BeforeSuite to configure logging for the entire test suite:
+// Level names are case-insensitive and surrounding whitespace is ignored, so
+// "debug" and " Info " are accepted. Numeric slog levels such as "-4" or "8"
+// are also accepted, which supports custom level schemes like a TRACE level
+// at slog.Level(-8) via LOG_LEVEL=-8. Named levels take precedence.
+//
+// Standard log package calls from legacy dependencies, which slog bridges
+// through the default handler, are emitted at INFO via
+// slog.SetLogLoggerLevel, so LOG_LEVEL filters them like any other INFO
+// record rather than letting them bypass the test log level.
+//
+// Setting LOG_FORMAT=json installs a JSON handler instead of the default text
+// handler, so suites exercise the same structured-logging path as production
+// and catch values that fail JSON marshaling.
+//
+// The configured logger is returned so it can also be injected explicitly
+// into components, keeping a single source of truth for level and format.
+//
+// This should be called in BeforeSuite to configure logging for the entire test suite,
+// paired with RestoreDefaultLogger in AfterSuite:
 //
 //	var _ = BeforeSuite(func() {
-//	    testlogger.ConfigureTestLogging()
+//	    logger := testlogger.ConfigureTestLogging()
+//	    server = NewServer(logger)
 //	    // Suite setup continues...
 //	})
-func ConfigureTestLogging() {
-	// By default, suppress INFO and WARN but allow ERROR for debugging
-	// slog levels: DEBUG=-4, INFO=0, WARN=4, ERROR=8
-	// Setting to 7 (just below ERROR) suppresses INFO and WARN
-	logLevel := slog.Level(7) // Just below ERROR to suppress INFO and WARN
-	output := os.Stderr       // Show ERROR logs to stderr for debugging
-
-	if lvl := os.Getenv("LOG_LEVEL"); lvl != "" {
-		switch lvl {
-		case "DEBUG":
-			logLevel = slog.LevelDebug
-			output = os.Stderr // Show output for debugging
-		case "INFO":
-			logLevel = slog.LevelInfo
-			output = os.Stderr
-		case "WARN":
-			logLevel = slog.LevelWarn
-			output = os.Stderr
-		case "ERROR":
-			// Show ERROR logs only
-			logLevel = slog.LevelError
-			output = os.Stderr
-		}
+//
+//	var _ = AfterSuite(func() {
+//	    testlogger.RestoreDefaultLogger()
+//	})
+func ConfigureTestLogging() *slog.Logger {
+	return ConfigureTestLoggingWithOptions(nil)
+}
+
+// configureOnce guards ConfigureTestLoggingOnce, and onceLogger holds the
+// logger installed by its first call.
+var (
+	configureOnce sync.Once
+	onceLogger    *slog.Logger
+)
+
+// ConfigureTestLoggingOnce is like ConfigureTestLogging but only configures
+// logging on its first call in the process; later calls return the same
+// logger without re-reading LOG_LEVEL or LOG_FORMAT or replacing the
+// default logger.
+//
+// Use it when several packages combined into one test binary each configure
+// suite logging, so the first configuration wins regardless of ordering.
+// ConfigureTestLogging, by contrast, reconfigures on every call. Calling
+// RestoreDefaultLogger does not re-arm ConfigureTestLoggingOnce.
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.ConfigureTestLoggingOnce()
+//	})
+func ConfigureTestLoggingOnce() *slog.Logger {
+	configureOnce.Do(func() {
+		onceLogger = ConfigureTestLogging()
+	})
+	return onceLogger
+}
+
+// ConfigureTestLoggingWithOptions is like ConfigureTestLogging but builds
+// the handler from opts, so tests can install the same ReplaceAttr hook used
+// in production, such as one renaming "msg" to "message" or dropping "time".
+//
+// If opts.Level is nil the level comes from LOG_LEVEL as usual; otherwise
+// opts.Level takes precedence. LOG_FORMAT still selects text or JSON output.
+// A nil opts is equivalent to ConfigureTestLogging.
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.ConfigureTestLoggingWithOptions(&slog.HandlerOptions{
+//	        ReplaceAttr: app.ReplaceLogAttr,
+//	    })
+//	})
+func ConfigureTestLoggingWithOptions(opts *slog.HandlerOptions) *slog.Logger {
+	// Show logs to stderr for debugging
+	logger := newTestLogger(os.Stderr, opts)
+	setDefaultLogger(logger)
+	slog.SetLogLoggerLevel(slog.LevelInfo)
+	return logger
+}
+
+// newTestLogger creates a logger writing to w with the format from
+// LOG_FORMAT. The level comes from LOG_LEVEL unless opts sets one.
+func newTestLogger(w io.Writer, opts *slog.HandlerOptions) *slog.Logger {
+	handlerOpts := slog.HandlerOptions{}
+	if opts != nil {
+		handlerOpts = *opts
+	}
+	if handlerOpts.Level == nil {
+		handlerOpts.Level = getLogLevel()
 	}
+	handler := textHandler
+	if getLogFormat() == FormatJSON {
+		handler = jsonHandler
+	}
+	return slog.New(handler(w, &handlerOpts))
+}
 
-	opts := &slog.HandlerOptions{
-		Level: logLevel,
+// getLogFormat reads the LOG_FORMAT environment variable, selecting JSON
+// output for "json" in any case and text output otherwise.
+func getLogFormat() LogFormat {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv("LOG_FORMAT")), "json") {
+		return FormatJSON
 	}
-	logger := slog.New(slog.NewTextHandler(output, opts))
-	slog.SetDefault(logger)
+	return FormatText
+}
+
+// ConfigureTestLoggingCaptured is like ConfigureTestLogging but installs a
+// default logger that writes to the returned buffer instead of stderr, so
+// suite-wide logging invariants can be asserted at the end of the run. The
+// returned function reinstates the previous default logger.
+//
+// LOG_LEVEL and LOG_FORMAT are honored as by ConfigureTestLogging, and the
+// bridged standard log package output is likewise filtered as INFO.
+//
+//	var suiteLogs *gbytes.Buffer
+//	var restoreLogging func()
+//
+//	var _ = BeforeSuite(func() {
+//	    suiteLogs, restoreLogging = testlogger.ConfigureTestLoggingCaptured()
+//	})
+//
+//	var _ = AfterSuite(func() {
+//	    restoreLogging()
+//	    testlogger.AssertNoErrorLogs(suiteLogs)
+//	})
+func ConfigureTestLoggingCaptured() (*gbytes.Buffer, func()) {
+	state := currentDefaultLoggerState()
+	buffer := gbytes.NewBuffer()
+	slog.SetDefault(newTestLogger(buffer, nil))
+	slog.SetLogLoggerLevel(slog.LevelInfo)
+	return buffer, state.restore
+}
+
+// ConfigureTestLoggingWithEnv is like ConfigureTestLogging but reads the log
+// level from envVar instead of LOG_LEVEL.
+//
+// This avoids collisions with an application's own LOG_LEVEL variable. The
+// chosen variable also applies to ExpectErrorLog and the other helpers that
+// honor LOG_LEVEL, until RestoreDefaultLogger reinstates the previous one.
+//
+//	var _ = BeforeSuite(func() {
+//	    testlogger.ConfigureTestLoggingWithEnv("TEST_LOG_LEVEL")
+//	})
+func ConfigureTestLoggingWithEnv(envVar string) *slog.Logger {
+	rememberDefaultLogger()
+	logLevelEnvVar = envVar
+	return ConfigureTestLogging()
+}
+
+// RestoreDefaultLogger reinstates the default logger, and the environment
+// variable the log level is read from, that were in place before the first
+// ConfigureTestLogging call, preventing one suite's logging configuration
+// from leaking into another suite run in the same binary.
+//
+// It is a no-op if logging has not been configured since the last restore.
+//
+//	var _ = AfterSuite(func() {
+//	    testlogger.RestoreDefaultLogger()
+//	})
+func RestoreDefaultLogger() {
+	if previousDefault == nil {
+		return
+	}
+	previousDefault.restore()
+	previousDefault = nil
+}
+
+// CaptureDefaultLogs temporarily replaces slog.Default() with a logger that
+// writes to the returned buffer, for testing code that calls slog.Info and
+// friends directly instead of accepting an injected logger.
+//
+// The returned function reinstates the previous default logger. Call it with
+// defer so the original logger is restored even if the test panics:
+//
+//	buffer, restore := testlogger.CaptureDefaultLogs(slog.LevelInfo)
+//	defer restore()
+//	legacy.Process()
+//	Expect(buffer).To(gbytes.Say("processing complete"))
+func CaptureDefaultLogs(level slog.Level) (*gbytes.Buffer, func()) {
+	state := currentDefaultLoggerState()
+	buffer := gbytes.NewBuffer()
+	slog.SetDefault(slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: level,
+	})))
+	return buffer, state.restore
 }
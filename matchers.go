@@ -0,0 +1,156 @@
+package testlogger
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/types"
+)
+
+// capturedContents extracts captured log output from a matcher's actual
+// value, which may be a *gbytes.Buffer, a gbytes.BufferProvider, a []byte or
+// a string.
+func capturedContents(actual any) (string, error) {
+	switch a := actual.(type) {
+	case *gbytes.Buffer:
+		return string(a.Contents()), nil
+	case gbytes.BufferProvider:
+		return string(a.Buffer().Contents()), nil
+	case []byte:
+		return string(a), nil
+	case string:
+		return a, nil
+	}
+	return "", fmt.Errorf("expected a *gbytes.Buffer, gbytes.BufferProvider, []byte or string, got:\n%s",
+		format.Object(actual, 1))
+}
+
+// HaveLoggedError succeeds if the captured output contains an ERROR level
+// record matching pattern, a regular expression. Both text and JSON output
+// are supported.
+//
+// The actual value may be a *gbytes.Buffer, a gbytes.BufferProvider, a
+// []byte or a string. Because the full contents are scanned on every match,
+// it composes with And/Or and polls correctly with Eventually.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	client := NewClient(logger)
+//	client.CallAPI()
+//	Expect(buffer).To(HaveLoggedError("rate limit exceeded"))
+//	Eventually(buffer).Should(HaveLoggedError("background sync failed"))
+func HaveLoggedError(pattern string) types.GomegaMatcher {
+	return &haveLoggedErrorMatcher{pattern: pattern}
+}
+
+type haveLoggedErrorMatcher struct {
+	pattern string
+}
+
+func (m *haveLoggedErrorMatcher) Match(actual any) (bool, error) {
+	contents, err := capturedContents(actual)
+	if err != nil {
+		return false, err
+	}
+	re, err := regexp.Compile(m.pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid log pattern %q: %w", m.pattern, err)
+	}
+	for _, record := range splitRecords(contents) {
+		if level, ok := recordLevel(record); ok && level >= slog.LevelError && re.MatchString(record) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *haveLoggedErrorMatcher) FailureMessage(actual any) string {
+	contents, _ := capturedContents(actual)
+	return format.Message(contents, "to contain an ERROR log matching", m.pattern)
+}
+
+func (m *haveLoggedErrorMatcher) NegatedFailureMessage(actual any) string {
+	contents, _ := capturedContents(actual)
+	return format.Message(contents, "not to contain an ERROR log matching", m.pattern)
+}
+
+// HaveLogCount succeeds if the captured output contains exactly count
+// records at level. Both text and JSON output are supported, and multi-line
+// records count once.
+//
+// The actual value may be a *gbytes.Buffer, a gbytes.BufferProvider, a
+// []byte or a string. Unlike the Assert* helpers it supports negation, and
+// its failure message shows how many records were found at each level.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	client := NewClient(logger)
+//	client.CallWithRetry()
+//	Expect(buffer).To(HaveLogCount(slog.LevelWarn, 2))
+//	Expect(buffer).NotTo(HaveLogCount(slog.LevelError, 0))
+func HaveLogCount(level slog.Level, count int) types.GomegaMatcher {
+	return &haveLogCountMatcher{level: level, count: count}
+}
+
+type haveLogCountMatcher struct {
+	level slog.Level
+	count int
+}
+
+func (m *haveLogCountMatcher) Match(actual any) (bool, error) {
+	contents, err := capturedContents(actual)
+	if err != nil {
+		return false, err
+	}
+	return levelCounts(contents)[m.level] == m.count, nil
+}
+
+func (m *haveLogCountMatcher) FailureMessage(actual any) string {
+	return m.message(actual, "to contain")
+}
+
+func (m *haveLogCountMatcher) NegatedFailureMessage(actual any) string {
+	return m.message(actual, "not to contain")
+}
+
+func (m *haveLogCountMatcher) message(actual any, expectation string) string {
+	contents, _ := capturedContents(actual)
+	return fmt.Sprintf("Expected captured logs %s %d %s record(s)\nFound: %s",
+		expectation, m.count, m.level, formatLevelCounts(levelCounts(contents)))
+}
+
+// levelCounts returns the number of records found at each level.
+func levelCounts(contents string) map[slog.Level]int {
+	counts := map[slog.Level]int{}
+	for _, record := range splitRecords(contents) {
+		if level, ok := recordLevel(record); ok {
+			counts[level]++
+		}
+	}
+	return counts
+}
+
+// formatLevelCounts renders level counts in ascending level order, for
+// example "INFO=3, WARN=2".
+func formatLevelCounts(counts map[slog.Level]int) string {
+	if len(counts) == 0 {
+		return "no records"
+	}
+	levels := make([]slog.Level, 0, len(counts))
+	for level := range counts {
+		levels = append(levels, level)
+	}
+	slices.Sort(levels)
+	parts := make([]string, len(levels))
+	for i, level := range levels {
+		parts[i] = fmt.Sprintf("%s=%d", level, counts[level])
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,93 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Matchers", func() {
+	Describe("HaveLoggedError", func() {
+		It("should match ERROR records in text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Warn("Rate limit approaching")
+			logger.Error("Request failed", "error", "rate limit exceeded")
+
+			Expect(buffer).To(testlogger.HaveLoggedError("rate limit exceeded"))
+			Expect(buffer).NotTo(testlogger.HaveLoggedError("approaching"))
+		})
+
+		It("should match ERROR records in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Error("Request failed", "status", 429)
+
+			Expect(buffer).To(And(
+				testlogger.HaveLoggedError(`"status":429`),
+				testlogger.HaveLoggedError("Request failed"),
+			))
+		})
+
+		It("should poll with Eventually for asynchronous logs", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				logger.Error("Background sync failed")
+			}()
+
+			Eventually(buffer).Should(testlogger.HaveLoggedError("Background sync failed"))
+		})
+
+		It("should describe the expectation on failure", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("All good")
+
+			failures := InterceptGomegaFailures(func() {
+				Expect(buffer).To(testlogger.HaveLoggedError("timeout"))
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to contain an ERROR log matching"))
+			Expect(failures[0]).To(ContainSubstring("All good"))
+		})
+
+		It("should reject unsupported actual values", func() {
+			_, err := testlogger.HaveLoggedError("x").Match(42)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Describe("HaveLogCount", func() {
+		It("should match the number of records at a level", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Warn("Retrying", "attempt", 1)
+			logger.Warn("Retrying", "attempt", 2)
+			logger.Error("Giving up")
+
+			Expect(buffer).To(testlogger.HaveLogCount(slog.LevelWarn, 2))
+			Expect(buffer).To(testlogger.HaveLogCount(slog.LevelError, 1))
+			Expect(buffer).NotTo(testlogger.HaveLogCount(slog.LevelError, 0))
+		})
+
+		It("should show the level distribution on failure", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("Started")
+			logger.Warn("Retrying")
+			logger.Warn("Retrying")
+
+			failures := InterceptGomegaFailures(func() {
+				Expect(buffer).To(testlogger.HaveLogCount(slog.LevelWarn, 3))
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to contain 3 WARN record(s)"))
+			Expect(failures[0]).To(ContainSubstring("Found: INFO=1, WARN=2"))
+		})
+	})
+})
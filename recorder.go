@@ -0,0 +1,171 @@
+package testlogger
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// RecordStore holds every slog.Record handled by a recording logger,
+// allowing assertions on levels, messages and attribute values without
+// scraping formatted text.
+//
+// It is safe for concurrent use.
+type RecordStore struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+// Records returns a copy of every record handled so far, in the order they
+// were logged. Attributes attached via Logger.With and Logger.WithGroup are
+// included on each record.
+func (s *RecordStore) Records() []slog.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]slog.Record, len(s.records))
+	for i, r := range s.records {
+		records[i] = r.Clone()
+	}
+	return records
+}
+
+func (s *RecordStore) add(r slog.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+}
+
+// recordingHandler is a slog.Handler that appends each record to a
+// RecordStore instead of formatting it.
+type recordingHandler struct {
+	store  *RecordStore
+	level  slog.Leveler
+	attrs  []slog.Attr
+	groups []string
+}
+
+func (h *recordingHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	var attrs []slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, resolveAttr(a))
+		return true
+	})
+	record := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	record.AddAttrs(h.attrs...)
+	record.AddAttrs(nestAttrs(h.groups, attrs)...)
+	h.store.add(record)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	resolved := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		resolved[i] = resolveAttr(a)
+	}
+	clone.attrs = append(slices.Clip(h.attrs), nestAttrs(h.groups, resolved)...)
+	return &clone
+}
+
+func (h *recordingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(slices.Clip(h.groups), name)
+	return &clone
+}
+
+// resolveAttr resolves any slog.LogValuer in a, including inside groups, so
+// stored records hold the same values the built-in handlers would output.
+func resolveAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() != slog.KindGroup {
+		return a
+	}
+	group := a.Value.Group()
+	resolved := make([]slog.Attr, len(group))
+	for i, member := range group {
+		resolved[i] = resolveAttr(member)
+	}
+	a.Value = slog.GroupValue(resolved...)
+	return a
+}
+
+// nestAttrs wraps attrs in the given groups, outermost first, mirroring how
+// the built-in handlers qualify attributes added after Logger.WithGroup.
+func nestAttrs(groups []string, attrs []slog.Attr) []slog.Attr {
+	if len(attrs) == 0 {
+		return nil
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+	return attrs
+}
+
+// WithRecordingLogger creates a logger that stores every record it handles
+// in a RecordStore, preserving level, message, time and attributes.
+//
+// This is useful when assertions need individual attribute values rather
+// than matching against formatted text output.
+//
+// Usage:
+//
+//	logger, store := WithRecordingLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	records := store.Records()
+//	Expect(records).To(HaveLen(1))
+//	Expect(records[0].Message).To(Equal("processing complete"))
+func WithRecordingLogger(level slog.Level) (*slog.Logger, *RecordStore) {
+	store := &RecordStore{}
+	logger := slog.New(&recordingHandler{store: store, level: level})
+	return logger, store
+}
+
+// AssertLogInterval validates that the first record with message msgB was
+// logged at least minGap after the first record with message msgA, using the
+// timestamps preserved by a recording logger.
+//
+// This is useful for verifying rate limiting or backoff delays. Record times
+// come from the wall clock, so keep minGap comfortably below the delay under
+// test to avoid flakiness.
+//
+// Usage:
+//
+//	logger, store := WithRecordingLogger(slog.LevelInfo)
+//	limiter := NewRateLimiter(logger, 100*time.Millisecond)
+//	limiter.Do()
+//	limiter.Do()
+//	AssertLogInterval(store.Records(), "request 1 sent", "request 2 sent", 100*time.Millisecond)
+func AssertLogInterval(records []slog.Record, msgA, msgB string, minGap time.Duration) {
+	first := func(msg string) (slog.Record, bool) {
+		for _, r := range records {
+			if r.Message == msg {
+				return r, true
+			}
+		}
+		return slog.Record{}, false
+	}
+
+	recordA, foundA := first(msgA)
+	if !Expect(foundA).To(BeTrue(), "No log record found with message %q", msgA) {
+		return
+	}
+	recordB, foundB := first(msgB)
+	if !Expect(foundB).To(BeTrue(), "No log record found with message %q", msgB) {
+		return
+	}
+	gap := recordB.Time.Sub(recordA.Time)
+	Expect(gap).To(BeNumerically(">=", minGap),
+		"Expected %q to be logged at least %s after %q but the gap was %s", msgB, minGap, msgA, gap)
+}
@@ -0,0 +1,123 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+// recordAttrs flattens the attributes of a record into a map for assertions.
+func recordAttrs(r slog.Record) map[string]slog.Value {
+	attrs := map[string]slog.Value{}
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	return attrs
+}
+
+var _ = Describe("Recording Logger", func() {
+	Describe("WithRecordingLogger", func() {
+		It("should record level, message and attributes", func() {
+			logger, store := testlogger.WithRecordingLogger(slog.LevelInfo)
+
+			logger.Debug("Filtered out")
+			logger.Error("Database connection failed", "host", "localhost", "port", 5432)
+
+			records := store.Records()
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Level).To(Equal(slog.LevelError))
+			Expect(records[0].Message).To(Equal("Database connection failed"))
+			Expect(records[0].Time.IsZero()).To(BeFalse())
+
+			attrs := recordAttrs(records[0])
+			Expect(attrs["host"].String()).To(Equal("localhost"))
+			Expect(attrs["port"].Int64()).To(Equal(int64(5432)))
+		})
+
+		It("should include attributes from With and WithGroup", func() {
+			logger, store := testlogger.WithRecordingLogger(slog.LevelDebug)
+
+			logger.With("service", "auth").WithGroup("http").Info("Request", "status", 200)
+
+			records := store.Records()
+			Expect(records).To(HaveLen(1))
+			attrs := recordAttrs(records[0])
+			Expect(attrs["service"].String()).To(Equal("auth"))
+			group := attrs["http"].Group()
+			Expect(group).To(HaveLen(1))
+			Expect(group[0].Key).To(Equal("status"))
+			Expect(group[0].Value.Int64()).To(Equal(int64(200)))
+		})
+
+		It("should resolve LogValuer attributes", func() {
+			logger, store := testlogger.WithRecordingLogger(slog.LevelInfo)
+
+			logger.With("base", credentials{User: "svc"}).
+				Info("Login", slog.Group("auth", "creds", credentials{User: "alice", Password: "hunter2"}))
+
+			records := store.Records()
+			Expect(records).To(HaveLen(1))
+			attrs := recordAttrs(records[0])
+			Expect(attrs["base"].Kind()).To(Equal(slog.KindString))
+			Expect(attrs["base"].String()).To(Equal("svc:[REDACTED]"))
+			group := attrs["auth"].Group()
+			Expect(group).To(HaveLen(1))
+			Expect(group[0].Value.String()).To(Equal("alice:[REDACTED]"))
+		})
+
+		It("should record concurrent logging safely", func() {
+			logger, store := testlogger.WithRecordingLogger(slog.LevelInfo)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					logger.Info("Concurrent log", "goroutine", id)
+				}(i)
+			}
+			wg.Wait()
+
+			Expect(store.Records()).To(HaveLen(50))
+		})
+	})
+	Describe("AssertLogInterval", func() {
+		It("should pass when records are far enough apart", func() {
+			logger, store := testlogger.WithRecordingLogger(slog.LevelInfo)
+
+			logger.Info("Request 1 sent")
+			time.Sleep(20 * time.Millisecond)
+			logger.Info("Request 2 sent")
+
+			testlogger.AssertLogInterval(store.Records(), "Request 1 sent", "Request 2 sent", 10*time.Millisecond)
+		})
+
+		It("should fail when records are too close together", func() {
+			now := time.Now()
+			records := []slog.Record{
+				slog.NewRecord(now, slog.LevelInfo, "Request 1 sent", 0),
+				slog.NewRecord(now.Add(5*time.Millisecond), slog.LevelInfo, "Request 2 sent", 0),
+			}
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogInterval(records, "Request 1 sent", "Request 2 sent", 10*time.Millisecond)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("the gap was 5ms"))
+		})
+
+		It("should report a missing record", func() {
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogInterval(nil, "Request 1 sent", "Request 2 sent", time.Millisecond)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`No log record found with message "Request 1 sent"`))
+		})
+	})
+})
@@ -0,0 +1,1097 @@
+package testlogger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+// textLevelPattern matches the level field of a slog.TextHandler record.
+// The level is always emitted before the message and attributes, optionally
+// preceded by the time field, so anchoring here avoids false positives from
+// message bodies that happen to contain "level=WARN".
+var textLevelPattern = regexp.MustCompile(`^(?:time=\S+ )?level=(\S+)`)
+
+// jsonLevelPattern matches the level field of a slog.JSONHandler record.
+var jsonLevelPattern = regexp.MustCompile(`^\{(?:"time":"[^"]*",)?"level":"([^"]+)"`)
+
+// recordLevel extracts the structured level field from a single log record
+// in either text or JSON format. It returns false if no level field is found.
+func recordLevel(record string) (slog.Level, bool) {
+	match := textLevelPattern.FindStringSubmatch(record)
+	if match == nil {
+		match = jsonLevelPattern.FindStringSubmatch(record)
+	}
+	if match == nil {
+		return 0, false
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(match[1])); err != nil {
+		return 0, false
+	}
+	return level, true
+}
+
+// splitRecords splits captured output into logical log records.
+//
+// A new record begins at each line carrying a structured level field. Lines
+// without one, such as the continuation of a stack trace written by a custom
+// handler, are joined onto the preceding record so that a multi-line message
+// is treated as a single entry. If no line carries a recognizable level
+// field, as with handlers using their own format, each line is a record.
+func splitRecords(contents string) []string {
+	lines := strings.Split(contents, "\n")
+	structured := slices.ContainsFunc(lines, func(line string) bool {
+		_, ok := recordLevel(line)
+		return ok
+	})
+
+	var records []string
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		if _, ok := recordLevel(line); ok || !structured || len(records) == 0 {
+			records = append(records, line)
+			continue
+		}
+		records[len(records)-1] += "\n" + line
+	}
+	return records
+}
+
+// recordsInLevelRange returns the records whose level is at least low and
+// below high.
+func recordsInLevelRange(contents string, low, high slog.Level) []string {
+	var matched []string
+	for _, record := range splitRecords(contents) {
+		if level, ok := recordLevel(record); ok && level >= low && level < high {
+			matched = append(matched, record)
+		}
+	}
+	return matched
+}
+
+// ParseJSONLogs parses the contents of a buffer written by a JSON logger
+// into one map per record, allowing field assertions that don't depend on key
+// ordering or spacing. Blank lines are skipped.
+//
+// JSON numbers are decoded as float64, following encoding/json.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	logger.Info("connected", "port", 5432)
+//	entries, err := ParseJSONLogs(buffer)
+//	Expect(err).NotTo(HaveOccurred())
+//	Expect(entries[0]["port"]).To(Equal(float64(5432)))
+func ParseJSONLogs(buffer *gbytes.Buffer) ([]map[string]any, error) {
+	var entries []map[string]any
+	for i, line := range strings.Split(string(buffer.Contents()), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("parsing JSON log line %d: %w", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// AssertAllLinesJSON validates that every non-empty line of the captured
+// output is a valid JSON object, failing with the offending line number and
+// content otherwise.
+//
+// This catches code that writes raw text into a JSON log stream, which would
+// break downstream JSON parsers.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelDebug)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	AssertAllLinesJSON(buffer)
+func AssertAllLinesJSON(buffer *gbytes.Buffer) {
+	for i, line := range strings.Split(string(buffer.Contents()), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var entry map[string]any
+		err := json.Unmarshal([]byte(line), &entry)
+		if !Expect(err).NotTo(HaveOccurred(), "Line %d is not valid JSON: %s", i+1, line) {
+			return
+		}
+	}
+}
+
+// AssertValidLogfmt validates that every non-empty line of the captured
+// output is well-formed logfmt: space-separated key=value pairs, with keys
+// and values quoted whenever they contain spaces, '=' or '"'. It fails with
+// the offending line number, content and the problem found.
+//
+// This catches messages or attribute values that corrupt the logfmt stream,
+// such as text written around the handler or a custom handler with broken
+// quoting, which would break downstream parsers.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	AssertValidLogfmt(buffer)
+func AssertValidLogfmt(buffer *gbytes.Buffer) {
+	for i, line := range strings.Split(string(buffer.Contents()), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		_, err := scanLogfmt(line, true)
+		if !Expect(err).NotTo(HaveOccurred(), "Line %d is not valid logfmt: %s", i+1, line) {
+			return
+		}
+	}
+}
+
+// AssertLogJSONSchema validates that every ERROR or higher record in the
+// captured JSON output has each schema key with a value of the given kind,
+// enforcing the log structure that downstream pipelines depend on. Keys of
+// grouped attributes are dotted paths such as "http.status".
+//
+// JSON has a single number type, so any numeric kind accepts a number, and
+// integer kinds additionally require it to be whole. Objects are
+// reflect.Map and arrays are reflect.Slice.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessInvalidData()
+//	AssertLogJSONSchema(buffer, map[string]reflect.Kind{
+//	    "trace_id": reflect.String,
+//	    "attempt":  reflect.Int,
+//	})
+func AssertLogJSONSchema(buffer *gbytes.Buffer, schema map[string]reflect.Kind) {
+	entries, err := ParseJSONLogs(buffer)
+	if !Expect(err).NotTo(HaveOccurred(), "Failed to parse captured JSON logs") {
+		return
+	}
+
+	var violations []string
+	for _, entry := range entries {
+		var level slog.Level
+		levelText, _ := entry[slog.LevelKey].(string)
+		if level.UnmarshalText([]byte(levelText)) != nil || level < slog.LevelError {
+			continue
+		}
+		for _, key := range slices.Sorted(maps.Keys(schema)) {
+			value, ok := lookupAttr(entry, key)
+			switch {
+			case !ok:
+				violations = append(violations, fmt.Sprintf("%q record is missing %q", entry[slog.MessageKey], key))
+			case !jsonKindMatches(value, schema[key]):
+				violations = append(violations, fmt.Sprintf("%q record has %q of kind %s, want %s",
+					entry[slog.MessageKey], key, reflect.ValueOf(value).Kind(), schema[key]))
+			}
+		}
+	}
+	Expect(violations).To(BeEmpty(), "Log records violate the JSON schema:\n%s", strings.Join(violations, "\n"))
+}
+
+// jsonKindMatches reports whether a decoded JSON value satisfies kind.
+func jsonKindMatches(value any, kind reflect.Kind) bool {
+	number, isNumber := value.(float64)
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return isNumber && number == math.Trunc(number)
+	case reflect.Float32, reflect.Float64:
+		return isNumber
+	default:
+		return reflect.ValueOf(value).Kind() == kind
+	}
+}
+
+// LogFormat identifies the handler format of captured output.
+type LogFormat int
+
+const (
+	// FormatText is the key=value output of slog.TextHandler.
+	FormatText LogFormat = iota
+	// FormatJSON is the one-object-per-line output of slog.JSONHandler.
+	FormatJSON
+)
+
+// String returns "text" or "JSON".
+func (f LogFormat) String() string {
+	if f == FormatJSON {
+		return "JSON"
+	}
+	return "text"
+}
+
+// DetectFormat reports whether captured output was written by a JSON or a
+// text handler, based on its first non-empty line: a valid JSON object means
+// JSON, and anything else, including empty output, is treated as text.
+//
+// The attribute assertions such as AssertLogAttr use this to work on any
+// buffer, whichever WithCaptured* function produced it.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	logger.Info("connected")
+//	Expect(DetectFormat(buffer.Contents())).To(Equal(FormatJSON))
+func DetectFormat(contents []byte) LogFormat {
+	return detectFormat(string(contents))
+}
+
+// detectFormat reports whether captured output was written by a JSON or a
+// text handler, based on its first non-empty line.
+func detectFormat(contents string) LogFormat {
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "{") && json.Valid([]byte(line)) {
+			return FormatJSON
+		}
+		return FormatText
+	}
+	return FormatText
+}
+
+// LogEntry is a single parsed log record. Attribute values are strings for
+// text output and decoded JSON values for JSON output; attributes inside
+// groups are stored under dotted keys for text output and as nested maps for
+// JSON output.
+type LogEntry struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   map[string]any
+	format  LogFormat
+}
+
+// Entries parses captured text or JSON output into typed log entries,
+// detecting the format automatically, so arbitrary assertions can be written
+// in plain Go rather than by matching strings. Continuation lines of
+// multi-line records are ignored.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	service := NewService(logger)
+//	service.ProcessData()
+//	entries, err := Entries(buffer)
+//	Expect(err).NotTo(HaveOccurred())
+//	Expect(entries).To(ContainElement(HaveField("Message", "processing complete")))
+func Entries(buffer *gbytes.Buffer) ([]LogEntry, error) {
+	return parseEntries(string(buffer.Contents()))
+}
+
+// parseEntries parses captured text or JSON output into log entries,
+// detecting the format automatically. Only the first line of a multi-line
+// record carries structured fields, so continuation lines are ignored.
+func parseEntries(contents string) ([]LogEntry, error) {
+	format := detectFormat(contents)
+	var entries []LogEntry
+	for i, record := range splitRecords(contents) {
+		entry, err := parseRecord(record, format)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s log record %d: %w", format, i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// parseRecord parses the first line of a single record in the given format.
+func parseRecord(record string, format LogFormat) (LogEntry, error) {
+	line, _, _ := strings.Cut(record, "\n")
+	var fields map[string]any
+	if format == FormatJSON {
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			return LogEntry{}, err
+		}
+	} else {
+		pairs, err := parseLogfmt(line)
+		if err != nil {
+			return LogEntry{}, err
+		}
+		fields = make(map[string]any, len(pairs))
+		for _, pair := range pairs {
+			fields[pair.key] = pair.value
+		}
+	}
+	return newLogEntry(fields, format), nil
+}
+
+// newLogEntry separates the built-in time, level, message and source fields
+// from the attributes of a parsed record.
+func newLogEntry(fields map[string]any, format LogFormat) LogEntry {
+	entry := LogEntry{Attrs: map[string]any{}, format: format}
+	for key, value := range fields {
+		text, _ := value.(string)
+		switch key {
+		case slog.TimeKey:
+			entry.Time, _ = time.Parse(time.RFC3339, text)
+		case slog.LevelKey:
+			_ = entry.Level.UnmarshalText([]byte(text))
+		case slog.MessageKey:
+			entry.Message = text
+		case slog.SourceKey:
+		default:
+			entry.Attrs[key] = value
+		}
+	}
+	return entry
+}
+
+// logfmtField is a single key=value pair from a text record.
+type logfmtField struct {
+	key   string
+	value string
+}
+
+// parseLogfmt splits a slog.TextHandler line into its key=value fields,
+// unquoting keys and values that the handler quoted.
+func parseLogfmt(line string) ([]logfmtField, error) {
+	return scanLogfmt(line, false)
+}
+
+// scanLogfmt implements parseLogfmt. In strict mode it also rejects
+// unquoted values containing '=' or '"', which slog.TextHandler always
+// quotes, so such values indicate a corrupted stream.
+func scanLogfmt(line string, strict bool) ([]logfmtField, error) {
+	var fields []logfmtField
+	for line != "" {
+		if line[0] == ' ' {
+			line = line[1:]
+			continue
+		}
+
+		var key string
+		if line[0] == '"' {
+			k, n, err := scanQuoted(line)
+			if err != nil {
+				return nil, err
+			}
+			key, line = k, line[n:]
+		} else {
+			end := strings.IndexAny(line, "= ")
+			if end < 0 {
+				end = len(line)
+			}
+			key, line = line[:end], line[end:]
+		}
+		if key == "" || !strings.HasPrefix(line, "=") {
+			return nil, fmt.Errorf("malformed field %q: expected key=value", key)
+		}
+		line = line[1:]
+
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			v, n, err := scanQuoted(line)
+			if err != nil {
+				return nil, err
+			}
+			value, line = v, line[n:]
+			if line != "" && line[0] != ' ' {
+				return nil, fmt.Errorf("malformed field %q: unexpected text after quoted value", key)
+			}
+		} else {
+			end := strings.IndexByte(line, ' ')
+			if end < 0 {
+				end = len(line)
+			}
+			value, line = line[:end], line[end:]
+			if strict && strings.ContainsAny(value, `="`) {
+				return nil, fmt.Errorf("malformed field %q: unquoted value %q contains '=' or '\"'", key, value)
+			}
+		}
+		fields = append(fields, logfmtField{key: key, value: value})
+	}
+	return fields, nil
+}
+
+// scanQuoted reads a Go-quoted string from the start of s and returns its
+// unquoted value and the number of bytes consumed.
+func scanQuoted(s string) (string, int, error) {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(s[:i+1])
+			return value, i + 1, err
+		}
+	}
+	return "", 0, errors.New("unterminated quoted string")
+}
+
+// attrEqual reports whether a parsed attribute value equals want. Text
+// values are compared against the formatted form of want, and JSON values
+// against want after a JSON round trip, so 5432 matches both port=5432 and
+// "port":5432.
+func attrEqual(got any, want any, format LogFormat) bool {
+	return reflect.DeepEqual(got, normalizeAttrValue(want, format))
+}
+
+// normalizeAttrValue converts want to the form a parsed attribute takes: its
+// rendered string for text output, or its decoded JSON value for JSON output.
+func normalizeAttrValue(want any, format LogFormat) any {
+	if format == FormatText {
+		return fmt.Sprint(want)
+	}
+	if data, err := json.Marshal(want); err == nil {
+		var normalized any
+		if json.Unmarshal(data, &normalized) == nil {
+			return normalized
+		}
+	}
+	return want
+}
+
+// parseBufferEntries parses the buffer contents, failing the test if the
+// output cannot be parsed.
+func parseBufferEntries(buffer *gbytes.Buffer) []LogEntry {
+	entries, err := parseEntries(string(buffer.Contents()))
+	Expect(err).NotTo(HaveOccurred(), "Failed to parse captured logs")
+	return entries
+}
+
+// entriesWithMessage returns the entries whose message equals msg exactly.
+func entriesWithMessage(entries []LogEntry, msg string) []LogEntry {
+	var matched []LogEntry
+	for _, entry := range entries {
+		if entry.Message == msg {
+			matched = append(matched, entry)
+		}
+	}
+	return matched
+}
+
+// lookupAttr finds an attribute by key. Keys of grouped attributes are
+// dotted paths such as "http.method": text output stores them flat under
+// the dotted key, while JSON output nests them in objects.
+func lookupAttr(attrs map[string]any, key string) (any, bool) {
+	if value, ok := attrs[key]; ok {
+		return value, true
+	}
+	group, rest, found := strings.Cut(key, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := attrs[group].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookupAttr(nested, rest)
+}
+
+// containsAttrKey reports whether key appears anywhere in attrs, including
+// as the final segment of a grouped key.
+func containsAttrKey(attrs map[string]any, key string) bool {
+	if _, ok := lookupAttr(attrs, key); ok {
+		return true
+	}
+	for k, value := range attrs {
+		if strings.HasSuffix(k, "."+key) {
+			return true
+		}
+		if nested, ok := value.(map[string]any); ok && containsAttrKey(nested, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// assertAttr validates that a record with message msg carries the attribute
+// at key with the given value.
+func assertAttr(buffer *gbytes.Buffer, msg string, key string, value any) {
+	matched := entriesWithMessage(parseBufferEntries(buffer), msg)
+	if !Expect(matched).NotTo(BeEmpty(), "No log record found with message %q", msg) {
+		return
+	}
+
+	attrMatched := false
+	var found []any
+	for _, entry := range matched {
+		if got, ok := lookupAttr(entry.Attrs, key); ok {
+			found = append(found, got)
+			attrMatched = attrMatched || attrEqual(got, value, entry.format)
+		}
+	}
+	Expect(attrMatched).To(BeTrue(),
+		"No %q log record has attribute %s=%v (found values: %v)", msg, key, value, found)
+}
+
+// AssertErrorLog validates that an ERROR or higher record matching pattern
+// was captured and returns the first such record, parsed, for follow-up
+// assertions on its attributes. Text and JSON output are both supported.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	db := NewDatabase(logger)
+//	db.Connect()
+//	entry := AssertErrorLog(buffer, "connection failed")
+//	Expect(entry.Attrs["host"]).To(Equal("localhost"))
+func AssertErrorLog(buffer *gbytes.Buffer, pattern string) LogEntry {
+	entry, found := findEntry(buffer, compilePatterns([]string{pattern})[0], slog.LevelError)
+	Expect(found).To(BeTrue(), "Expected ERROR log pattern not found: %s", pattern)
+	return entry
+}
+
+// findEntry parses the first record at or above minLevel that matches re.
+func findEntry(buffer *gbytes.Buffer, re *regexp.Regexp, minLevel slog.Level) (LogEntry, bool) {
+	contents := string(buffer.Contents())
+	format := detectFormat(contents)
+	for _, record := range splitRecords(contents) {
+		if level, ok := recordLevel(record); !ok || level < minLevel || !re.MatchString(record) {
+			continue
+		}
+		entry, err := parseRecord(record, format)
+		if !Expect(err).NotTo(HaveOccurred(), "Failed to parse matching log record") {
+			return LogEntry{}, false
+		}
+		return entry, true
+	}
+	return LogEntry{}, false
+}
+
+// AssertLogBefore validates that the first record in bufA matching patternA
+// was logged at or before the first record in bufB matching patternB, using
+// the records' timestamps. This orders events across components that log to
+// separate buffers, such as the members of a LoggerGroup.
+//
+// Text output records time with millisecond precision, so events closer
+// together than that compare as simultaneous and pass. LoggerGroup loggers
+// and CaptureBuilder.PreciseTime record nanoseconds instead.
+//
+// Usage:
+//
+//	group := NewLoggerGroup()
+//	client := NewClient(group.Logger("client"))
+//	server := NewServer(group.Logger("server"))
+//	client.Call(server)
+//	AssertLogBefore(group.Buffer("client"), "request sent", group.Buffer("server"), "request received")
+func AssertLogBefore(bufA *gbytes.Buffer, patternA string, bufB *gbytes.Buffer, patternB string) {
+	anyLevel := slog.Level(math.MinInt)
+	entryA, foundA := findEntry(bufA, compilePatterns([]string{patternA})[0], anyLevel)
+	if !Expect(foundA).To(BeTrue(), "Expected log pattern not found: %s", patternA) {
+		return
+	}
+	entryB, foundB := findEntry(bufB, compilePatterns([]string{patternB})[0], anyLevel)
+	if !Expect(foundB).To(BeTrue(), "Expected log pattern not found: %s", patternB) {
+		return
+	}
+	if !Expect(entryA.Time.IsZero() || entryB.Time.IsZero()).To(BeFalse(),
+		"Log records must carry timestamps to be ordered") {
+		return
+	}
+	Expect(entryA.Time).NotTo(BeTemporally(">", entryB.Time),
+		"Expected %q to be logged at or before %q, but it was logged %s later",
+		patternA, patternB, entryA.Time.Sub(entryB.Time))
+}
+
+// AssertMatchedLevelAtLeast validates that every record matching pattern
+// was logged at min or above, catching severity downgrades that string
+// matching alone cannot detect because the message text is unchanged. The
+// assertion also fails if no record matches pattern.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	db := NewDatabase(logger)
+//	db.Connect()
+//	AssertMatchedLevelAtLeast(buffer, "db connection failed", slog.LevelError)
+func AssertMatchedLevelAtLeast(buffer *gbytes.Buffer, pattern string, min slog.Level) {
+	re := compilePatterns([]string{pattern})[0]
+	matched := 0
+	var below []string
+	for _, record := range splitRecords(string(buffer.Contents())) {
+		if !re.MatchString(record) {
+			continue
+		}
+		matched++
+		if level, ok := recordLevel(record); !ok || level < min {
+			below = append(below, record)
+		}
+	}
+	if !Expect(matched).To(BeNumerically(">", 0), "Expected log pattern not found: %s", pattern) {
+		return
+	}
+	Expect(below).To(BeEmpty(),
+		"Expected records matching %s to be logged at %s or above, but found:\n  %s",
+		pattern, min, strings.Join(below, "\n  "))
+}
+
+// AssertFirstLog validates that the first captured record matches pattern,
+// which is useful for checking startup ordering. The assertion fails with
+// "no logs were produced" if the buffer is empty.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	server := NewServer(logger)
+//	server.Start()
+//	AssertFirstLog(buffer, "loading configuration")
+func AssertFirstLog(buffer *gbytes.Buffer, pattern string) {
+	assertEdgeLog(buffer, pattern, "first", func(records []string) string {
+		return records[0]
+	})
+}
+
+// AssertLastLog validates that the last captured record matches pattern,
+// which is useful for checking shutdown ordering. The assertion fails with
+// "no logs were produced" if the buffer is empty.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	server := NewServer(logger)
+//	server.Shutdown()
+//	AssertLastLog(buffer, "shutdown complete")
+func AssertLastLog(buffer *gbytes.Buffer, pattern string) {
+	assertEdgeLog(buffer, pattern, "last", func(records []string) string {
+		return records[len(records)-1]
+	})
+}
+
+// assertEdgeLog validates that the record chosen by pick, described by
+// position, matches pattern.
+func assertEdgeLog(buffer *gbytes.Buffer, pattern string, position string, pick func([]string) string) {
+	re := compilePatterns([]string{pattern})[0]
+	records := splitRecords(string(buffer.Contents()))
+	if !Expect(records).NotTo(BeEmpty(), "Expected %s log to match %s, but no logs were produced", position, pattern) {
+		return
+	}
+	record := pick(records)
+	Expect(re.MatchString(record)).To(BeTrue(),
+		"Expected %s log to match %s, but it was: %s", position, pattern, record)
+}
+
+// AssertLogMessageEquals validates that at least one record at level has a
+// message exactly equal to msg, regardless of its attributes. Unlike
+// gbytes.Say, "user" does not match "user login failed". Text and JSON
+// output are both supported.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	auth := NewAuthenticator(logger)
+//	auth.Login("user", "wrong")
+//	AssertLogMessageEquals(buffer, slog.LevelWarn, "login failed")
+func AssertLogMessageEquals(buffer *gbytes.Buffer, level slog.Level, msg string) {
+	var messages []string
+	for _, entry := range parseBufferEntries(buffer) {
+		if entry.Level != level {
+			continue
+		}
+		if entry.Message == msg {
+			return
+		}
+		messages = append(messages, entry.Message)
+	}
+	Expect(messages).To(ContainElement(msg),
+		"No %s log record has message %q (found messages: %q)", level, msg, messages)
+}
+
+// AssertLogAttr validates that a log record with message msg carries the
+// attribute key with the given value, regardless of whether the buffer holds
+// text or JSON output.
+//
+// When several records share the message, at least one must match. Values
+// are compared by their rendered form, so port 5432 matches both
+// port=5432 and "port":5432.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	db := NewDatabase(logger)
+//	db.Connect()
+//	AssertLogAttr(buffer, "connected", "port", 5432)
+func AssertLogAttr(buffer *gbytes.Buffer, msg string, key string, value any) {
+	assertAttr(buffer, msg, key, value)
+}
+
+// AssertResolvedAttr is like AssertLogAttr but documents intent for values
+// that implement slog.LogValuer: the attribute is compared by its resolved
+// form, not by the raw value that was passed to the logger. The capture
+// handlers resolve LogValuer values when a record is handled, exactly as the
+// built-in slog handlers do.
+//
+// This makes it possible to assert the output of types that redact
+// themselves in LogValue. If resolvedValue is itself a LogValuer it is
+// resolved before comparison.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	logger.Info("login", "user", User{Name: "alice", Password: "secret"})
+//	AssertResolvedAttr(buffer, "login", "user", "alice")
+func AssertResolvedAttr(buffer *gbytes.Buffer, msg string, key string, resolvedValue any) {
+	if valuer, ok := resolvedValue.(slog.LogValuer); ok {
+		resolvedValue = slog.AnyValue(valuer).Resolve().Any()
+	}
+	assertAttr(buffer, msg, key, resolvedValue)
+}
+
+// AssertLoggedError validates that at least one record carries an "error"
+// attribute whose rendered value contains target.Error(). This documents
+// that the error was attached as a structured attribute, as in
+// logger.Error("fetch failed", "error", err), rather than concatenated into
+// the message.
+//
+// A wrapped error matches the errors it wraps, since fmt.Errorf with %w
+// includes the wrapped message in its own.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	client := NewClient(logger)
+//	client.Fetch()
+//	AssertLoggedError(buffer, syscall.ECONNREFUSED)
+func AssertLoggedError(buffer *gbytes.Buffer, target error) {
+	if !Expect(target).NotTo(BeNil(), "AssertLoggedError requires a non-nil target error") {
+		return
+	}
+	var found []string
+	for _, entry := range parseBufferEntries(buffer) {
+		value, ok := entry.Attrs["error"]
+		if !ok {
+			continue
+		}
+		rendered := fmt.Sprint(value)
+		if strings.Contains(rendered, target.Error()) {
+			return
+		}
+		found = append(found, rendered)
+	}
+	Expect(found).To(ContainElement(ContainSubstring(target.Error())),
+		"No log record has an error attribute containing %q (found errors: %q)", target.Error(), found)
+}
+
+// AssertLogAttrMatches validates that a record with message msg carries the
+// attribute key with a value matching the regular expression valuePattern.
+// Use it for nondeterministic values such as generated IDs, where equality
+// is impossible but the shape can still be checked.
+//
+// When several records share the message, at least one must match. The
+// value is matched in its rendered form, so JSON numbers are matched as
+// they print, such as 5432.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	tracer := NewTracer(logger)
+//	tracer.StartSpan()
+//	AssertLogAttrMatches(buffer, "span started", "trace_id", `^[0-9a-f]{32}$`)
+func AssertLogAttrMatches(buffer *gbytes.Buffer, msg string, key string, valuePattern string) {
+	re := compilePatterns([]string{valuePattern})[0]
+	matched := entriesWithMessage(parseBufferEntries(buffer), msg)
+	if !Expect(matched).NotTo(BeEmpty(), "No log record found with message %q", msg) {
+		return
+	}
+
+	var found []string
+	for _, entry := range matched {
+		if got, ok := lookupAttr(entry.Attrs, key); ok {
+			rendered := fmt.Sprint(got)
+			if re.MatchString(rendered) {
+				return
+			}
+			found = append(found, rendered)
+		}
+	}
+	Expect(found).To(ContainElement(MatchRegexp(valuePattern)),
+		"No %q log record has attribute %s matching %s (found values: %q)", msg, key, valuePattern, found)
+}
+
+// AssertLogAttrCount validates that every record with message msg carries
+// exactly want attributes, excluding the built-in time, level, msg and source
+// fields. This catches regressions where an unintended attribute, possibly a
+// sensitive one, is added to a log line.
+//
+// JSON output counts top-level keys, so a slog.Group counts once; text output
+// counts each key=value token, so every attribute in a group counts.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	auth := NewAuthenticator(logger)
+//	auth.Login("user", "hunter2")
+//	AssertLogAttrCount(buffer, "login succeeded", 1)
+func AssertLogAttrCount(buffer *gbytes.Buffer, msg string, want int) {
+	matched := entriesWithMessage(parseBufferEntries(buffer), msg)
+	if !Expect(matched).NotTo(BeEmpty(), "No log record found with message %q", msg) {
+		return
+	}
+
+	for _, entry := range matched {
+		keys := slices.Sorted(maps.Keys(entry.Attrs))
+		if !Expect(keys).To(HaveLen(want),
+			"Expected %q log record to have %d attributes but found %d: %v", msg, want, len(keys), keys) {
+			return
+		}
+	}
+}
+
+// AssertLogGroupAttr is like AssertLogAttr but validates an attribute inside
+// a slog.Group, understanding both the dotted text form (http.method=GET)
+// and the nested JSON form ({"http":{"method":"GET"}}).
+//
+// Nested groups are addressed with a dotted groupName such as "http.request".
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	logger.Info("request", slog.Group("http", "method", "GET", "status", 200))
+//	AssertLogGroupAttr(buffer, "request", "http", "status", 200)
+func AssertLogGroupAttr(buffer *gbytes.Buffer, msg string, groupName string, key string, value any) {
+	assertAttr(buffer, msg, groupName+"."+key, value)
+}
+
+// AssertNoLogAttr validates that no captured record carries the attribute
+// key, reporting the messages of any records that do. Attributes are parsed
+// from text or JSON output, so the key appearing inside a value is not a
+// false positive, and keys inside groups are found as well.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelDebug)
+//	service := NewCustomerService(logger)
+//	service.Register(customer)
+//	AssertNoLogAttr(buffer, "ssn")
+func AssertNoLogAttr(buffer *gbytes.Buffer, key string) {
+	var offending []string
+	for _, entry := range parseBufferEntries(buffer) {
+		if containsAttrKey(entry.Attrs, key) {
+			offending = append(offending, entry.Message)
+		}
+	}
+	Expect(offending).To(BeEmpty(),
+		"Expected no log record to have attribute %q, but these records did: %q", key, offending)
+}
+
+// AssertAttrValuesExactly validates that the values of attribute key across
+// all captured records are exactly values, in any order, with each value
+// appearing as many times as it is listed. Records without the attribute are
+// ignored. Values are compared as in AssertLogAttr.
+//
+// This confirms that no records were lost or duplicated under contention,
+// regardless of how concurrent output is interleaved.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	var wg sync.WaitGroup
+//	for i := range 3 {
+//	    wg.Add(1)
+//	    go func() {
+//	        defer wg.Done()
+//	        logger.Info("worker done", "worker", i)
+//	    }()
+//	}
+//	wg.Wait()
+//	AssertAttrValuesExactly(buffer, "worker", 0, 1, 2)
+func AssertAttrValuesExactly(buffer *gbytes.Buffer, key string, values ...any) {
+	entries := parseBufferEntries(buffer)
+	format := DetectFormat(buffer.Contents())
+
+	var found []any
+	for _, entry := range entries {
+		if got, ok := lookupAttr(entry.Attrs, key); ok {
+			found = append(found, got)
+		}
+	}
+	expected := make([]any, len(values))
+	for i, value := range values {
+		expected[i] = normalizeAttrValue(value, format)
+	}
+	Expect(found).To(ConsistOf(expected...), "Unexpected values for attribute %q", key)
+}
+
+// AssertAttrIncreasing validates that the numeric values of attribute key
+// strictly increase across captured records, in the order they were logged.
+// Records without the attribute are ignored, and integer and float
+// encodings are compared by value in both text and JSON output.
+//
+// The assertion fails if the attribute never appears, if a value is not
+// numeric, or at the first value not greater than its predecessor, naming
+// the record it came from.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	client := NewClient(logger)
+//	client.CallWithRetry()
+//	AssertAttrIncreasing(buffer, "attempt")
+func AssertAttrIncreasing(buffer *gbytes.Buffer, key string) {
+	entries := parseBufferEntries(buffer)
+
+	found := false
+	var previous float64
+	for i, entry := range entries {
+		got, ok := lookupAttr(entry.Attrs, key)
+		if !ok {
+			continue
+		}
+		value, numeric := numericValue(parsedValue(got, entry.format))
+		if !Expect(numeric).To(BeTrue(),
+			"Attribute %q of record %d (%q) is not numeric: %v", key, i+1, entry.Message, got) {
+			return
+		}
+		if found && !Expect(value).To(BeNumerically(">", previous),
+			"Attribute %q is not strictly increasing: record %d (%q) has %v after %v",
+			key, i+1, entry.Message, value, previous) {
+			return
+		}
+		found, previous = true, value
+	}
+	Expect(found).To(BeTrue(), "No log record has attribute %q", key)
+}
+
+// AssertBaseAttr validates that every captured record carries the attribute
+// key with the given value, as attached by Logger.With. This catches wrapper
+// code that accidentally drops base attributes from some records.
+//
+// Text and JSON output are both supported, and values are compared as in
+// AssertLogAttr. The assertion fails if no records were captured.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedLogger(slog.LevelInfo)
+//	service := NewService(logger.With("service", "auth"))
+//	service.ProcessData()
+//	AssertBaseAttr(buffer, "service", "auth")
+func AssertBaseAttr(buffer *gbytes.Buffer, key string, value any) {
+	entries := parseBufferEntries(buffer)
+	if !Expect(entries).NotTo(BeEmpty(), "No log records found") {
+		return
+	}
+
+	var missing []string
+	for _, entry := range entries {
+		got, ok := lookupAttr(entry.Attrs, key)
+		if !ok || !attrEqual(got, value, entry.format) {
+			missing = append(missing, entry.Message)
+		}
+	}
+	Expect(missing).To(BeEmpty(),
+		"Expected every log record to have attribute %s=%v, but these records did not: %q", key, value, missing)
+}
+
+// parsedValue reconstructs a typed slog.Value from a parsed attribute. JSON
+// output preserves the distinction between strings, numbers and booleans;
+// text output does not, so its values are inferred from their rendering.
+func parsedValue(got any, format LogFormat) slog.Value {
+	if format == FormatText {
+		s := fmt.Sprint(got)
+		if s == "true" || s == "false" {
+			return slog.BoolValue(s == "true")
+		}
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return slog.Int64Value(i)
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return slog.Float64Value(f)
+		}
+		return slog.StringValue(s)
+	}
+	switch v := got.(type) {
+	case string:
+		return slog.StringValue(v)
+	case bool:
+		return slog.BoolValue(v)
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return slog.Int64Value(int64(v))
+		}
+		return slog.Float64Value(v)
+	default:
+		return slog.AnyValue(v)
+	}
+}
+
+// numericValue reports the value of a numeric slog.Value as a float64.
+func numericValue(v slog.Value) (float64, bool) {
+	switch v.Kind() {
+	case slog.KindInt64:
+		return float64(v.Int64()), true
+	case slog.KindUint64:
+		return float64(v.Uint64()), true
+	case slog.KindFloat64:
+		return v.Float64(), true
+	default:
+		return 0, false
+	}
+}
+
+// valueEqual compares a parsed attribute with an expected slog.Value. Numeric
+// kinds compare by value, since JSON does not distinguish int from float.
+// Kinds with no scalar JSON form, such as durations and times, fall back to
+// comparing the rendered form as AssertLogAttr does.
+func valueEqual(got any, want slog.Value, format LogFormat) bool {
+	switch want.Kind() {
+	case slog.KindString, slog.KindBool, slog.KindInt64, slog.KindUint64, slog.KindFloat64:
+	default:
+		return attrEqual(got, want.Any(), format)
+	}
+
+	parsed := parsedValue(got, format)
+	gotNum, gotIsNum := numericValue(parsed)
+	wantNum, wantIsNum := numericValue(want)
+	if gotIsNum && wantIsNum {
+		return gotNum == wantNum
+	}
+	return parsed.Equal(want)
+}
+
+// AssertLogAttrValue is like AssertLogAttr but compares the attribute as a
+// typed slog.Value rather than by its rendered form, so a port logged as the
+// string "5432" does not match slog.IntValue(5432) in JSON output.
+//
+// Text output carries no type information, so there the kind is inferred:
+// true and false are booleans, numeric renderings are numbers and anything
+// else is a string. Capture JSON output when the distinction matters.
+//
+// Usage:
+//
+//	logger, buffer := WithCapturedJSONLogger(slog.LevelInfo)
+//	db := NewDatabase(logger)
+//	db.Connect()
+//	AssertLogAttrValue(buffer, "connected", "port", slog.IntValue(5432))
+func AssertLogAttrValue(buffer *gbytes.Buffer, msg string, key string, want slog.Value) {
+	matched := entriesWithMessage(parseBufferEntries(buffer), msg)
+	if !Expect(matched).NotTo(BeEmpty(), "No log record found with message %q", msg) {
+		return
+	}
+
+	attrMatched := false
+	var found []string
+	for _, entry := range matched {
+		if got, ok := lookupAttr(entry.Attrs, key); ok {
+			parsed := parsedValue(got, entry.format)
+			found = append(found, fmt.Sprintf("%v (%s)", parsed, parsed.Kind()))
+			attrMatched = attrMatched || valueEqual(got, want, entry.format)
+		}
+	}
+	Expect(attrMatched).To(BeTrue(),
+		"No %q log record has attribute %s=%v (%s) (found values: %v)", msg, key, want, want.Kind(), found)
+}
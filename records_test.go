@@ -0,0 +1,789 @@
+package testlogger_test
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+// credentials redacts its secret when logged.
+type credentials struct {
+	User     string
+	Password string
+}
+
+func (c credentials) LogValue() slog.Value {
+	return slog.StringValue(c.User + ":[REDACTED]")
+}
+
+var _ = Describe("Record Parsing", func() {
+	Describe("ParseJSONLogs", func() {
+		It("should parse each JSON record into a map", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "host", "localhost", "port", 5432)
+			logger.Error("Query failed", "retry", true)
+
+			entries, err := testlogger.ParseJSONLogs(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0]).To(HaveKeyWithValue("host", "localhost"))
+			Expect(entries[0]).To(HaveKeyWithValue("port", float64(5432)))
+			Expect(entries[1]).To(HaveKeyWithValue("level", "ERROR"))
+			Expect(entries[1]).To(HaveKeyWithValue("retry", true))
+		})
+
+		It("should skip blank lines", func() {
+			buffer := gbytes.BufferWithBytes([]byte("\n{\"msg\":\"first\"}\n\n{\"msg\":\"second\"}\n"))
+
+			entries, err := testlogger.ParseJSONLogs(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+		})
+
+		It("should identify the line that fails to parse", func() {
+			buffer := gbytes.BufferWithBytes([]byte("{\"msg\":\"first\"}\nnot json\n"))
+
+			_, err := testlogger.ParseJSONLogs(buffer)
+			Expect(err).To(MatchError(ContainSubstring("line 2")))
+		})
+	})
+	Describe("AssertAllLinesJSON", func() {
+		It("should pass when every line is a JSON object", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Info("First", "count", 1)
+			logger.Error("Second\nwith newline")
+
+			testlogger.AssertAllLinesJSON(buffer)
+		})
+
+		It("should report the first line that isn't JSON", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelDebug)
+
+			logger.Info("First")
+			buffer.Write([]byte("raw text written directly\n"))
+			logger.Info("Third")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertAllLinesJSON(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Line 2 is not valid JSON: raw text written directly"))
+		})
+	})
+
+	Describe("AssertValidLogfmt", func() {
+		It("should pass for text handler output with awkward values", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("key=value in message", "query", `name="bob"`, "empty", "")
+			logger.Error("Multi\nline", slog.Group("http", "path", "/a b"))
+
+			testlogger.AssertValidLogfmt(buffer)
+		})
+
+		It("should report a line with an unquoted '=' in a value", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Info("First")
+			buffer.Write([]byte("level=INFO msg=broken filter=a=b\n"))
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertValidLogfmt(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Line 2 is not valid logfmt: level=INFO msg=broken filter=a=b"))
+			Expect(failures[0]).To(ContainSubstring(`malformed field "filter"`))
+		})
+
+		It("should report a line that is not key=value pairs", func() {
+			buffer := gbytes.BufferWithBytes([]byte("raw text written directly\n"))
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertValidLogfmt(buffer)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Line 1 is not valid logfmt"))
+		})
+	})
+
+	Describe("DetectFormat", func() {
+		It("should detect JSON output from the first non-empty line", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected")
+
+			Expect(testlogger.DetectFormat(append([]byte("\n"), buffer.Contents()...))).To(Equal(testlogger.FormatJSON))
+		})
+
+		It("should treat text and empty output as text", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "payload", `{"id":1}`)
+
+			Expect(testlogger.DetectFormat(buffer.Contents())).To(Equal(testlogger.FormatText))
+			Expect(testlogger.DetectFormat(nil)).To(Equal(testlogger.FormatText))
+			Expect(testlogger.FormatText.String()).To(Equal("text"))
+		})
+	})
+
+	Describe("Entries", func() {
+		It("should parse text output into typed entries", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "host", "localhost")
+			logger.Warn("Slow query", slog.Group("db", "table", "users"))
+
+			entries, err := testlogger.Entries(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].Level).To(Equal(slog.LevelInfo))
+			Expect(entries[0].Message).To(Equal("Connected"))
+			Expect(entries[0].Attrs).To(Equal(map[string]any{"host": "localhost"}))
+			Expect(entries[1].Attrs).To(Equal(map[string]any{"db.table": "users"}))
+			Expect(entries[1].Time).NotTo(BeZero())
+		})
+
+		It("should parse JSON output into typed entries", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Warn("Slow query", slog.Group("db", "table", "users"), "ms", 900)
+
+			entries, err := testlogger.Entries(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(ConsistOf(HaveField("Message", "Slow query")))
+			Expect(entries[0].Level).To(Equal(slog.LevelWarn))
+			Expect(entries[0].Attrs).To(Equal(map[string]any{
+				"db": map[string]any{"table": "users"},
+				"ms": float64(900),
+			}))
+		})
+
+		It("should report malformed records", func() {
+			buffer := gbytes.BufferWithBytes([]byte("level=INFO msg=\"unterminated\n"))
+
+			_, err := testlogger.Entries(buffer)
+			Expect(err).To(MatchError(ContainSubstring("parsing text log record 1")))
+		})
+	})
+
+	Describe("AssertErrorLog", func() {
+		It("should return the parsed matching ERROR record", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connecting", "host", "localhost")
+			logger.Error("Connection failed", "host", "localhost", "port", 5432)
+
+			entry := testlogger.AssertErrorLog(buffer, "Connection failed")
+			Expect(entry.Level).To(Equal(slog.LevelError))
+			Expect(entry.Message).To(Equal("Connection failed"))
+			Expect(entry.Attrs).To(Equal(map[string]any{"host": "localhost", "port": "5432"}))
+		})
+
+		It("should decode JSON attribute values", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Error("Connection failed", "port", 5432)
+
+			entry := testlogger.AssertErrorLog(buffer, `"port":\d+`)
+			Expect(entry.Attrs["port"]).To(BeNumerically("==", 5432))
+			Expect(entry.Time).NotTo(BeZero())
+		})
+
+		It("should ignore matching records below ERROR", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Warn("Connection failed")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertErrorLog(buffer, "Connection failed")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected ERROR log pattern not found: Connection failed"))
+		})
+	})
+
+	Describe("AssertLogBefore", func() {
+		It("should pass when the first buffer's record was logged earlier", func() {
+			group := testlogger.NewLoggerGroup()
+
+			group.Logger("client").Error("Request sent")
+			group.Logger("server").Error("Request received")
+
+			testlogger.AssertLogBefore(group.Buffer("client"), "Request sent", group.Buffer("server"), "Request received")
+		})
+
+		It("should report how much later the first record was logged", func() {
+			start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			client, clientBuffer := testlogger.NewCapture().
+				Clock(func() time.Time { return start.Add(time.Millisecond) }).
+				PreciseTime().
+				Build()
+			server, serverBuffer := testlogger.NewCapture().
+				Clock(func() time.Time { return start }).
+				PreciseTime().
+				Build()
+
+			server.Info("Request received")
+			client.Info("Request sent")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogBefore(clientBuffer, "Request sent", serverBuffer, "Request received")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`Expected "Request sent" to be logged at or before "Request received", but it was logged 1ms later`))
+		})
+
+		It("should report a missing record", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Request sent")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogBefore(buffer, "Request sent", buffer, "Response received")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("Expected log pattern not found: Response received"))
+		})
+	})
+
+	Describe("AssertLogMessageEquals", func() {
+		It("should match exact text and JSON messages", func() {
+			textLogger, textBuffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			jsonLogger, jsonBuffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			textLogger.Warn("Login failed", "user", "alice")
+			jsonLogger.Warn("Login failed", "user", "alice")
+
+			testlogger.AssertLogMessageEquals(textBuffer, slog.LevelWarn, "Login failed")
+			testlogger.AssertLogMessageEquals(jsonBuffer, slog.LevelWarn, "Login failed")
+		})
+
+		It("should not match a superstring or a different level", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Warn("User login failed")
+			logger.Info("User")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogMessageEquals(buffer, slog.LevelWarn, "User")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`No WARN log record has message "User" (found messages: ["User login failed"])`))
+		})
+	})
+
+	Describe("AssertMatchedLevelAtLeast", func() {
+		It("should pass when every matching record meets the minimum level", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Debug("Connecting to database")
+			logger.Error("db connection failed", "attempt", 1)
+			logger.Error("db connection failed", "attempt", 2)
+
+			testlogger.AssertMatchedLevelAtLeast(buffer, "db connection failed", slog.LevelError)
+		})
+
+		It("should fail when a matching record was downgraded", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelDebug)
+
+			logger.Error("db connection failed", "attempt", 1)
+			logger.Warn("db connection failed", "attempt", 2)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertMatchedLevelAtLeast(buffer, "db connection failed", slog.LevelError)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("level=WARN"))
+			Expect(failures[0]).NotTo(ContainSubstring("attempt=1"))
+		})
+
+		It("should fail when no record matches", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertMatchedLevelAtLeast(buffer, "db connection failed", slog.LevelError)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("not found"))
+		})
+	})
+
+	Describe("AssertAttrIncreasing", func() {
+		It("should pass for strictly increasing integers and floats", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Retrying", "attempt", 1, "backoff", 0.5)
+			logger.Info("Unrelated")
+			logger.Info("Retrying", "attempt", 2, "backoff", 1.0)
+			logger.Info("Retrying", "attempt", 3, "backoff", 2.5)
+
+			testlogger.AssertAttrIncreasing(buffer, "attempt")
+			testlogger.AssertAttrIncreasing(buffer, "backoff")
+		})
+
+		It("should pinpoint the first out-of-order value", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Retrying", "attempt", 1)
+			logger.Info("Retrying", "attempt", 3)
+			logger.Info("Retrying again", "attempt", 3)
+			logger.Info("Retrying", "attempt", 2)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertAttrIncreasing(buffer, "attempt")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`not strictly increasing: record 3 ("Retrying again") has 3 after 3`))
+		})
+
+		It("should fail on non-numeric or missing values", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Retrying", "attempt", "first")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertAttrIncreasing(buffer, "attempt")
+				testlogger.AssertAttrIncreasing(buffer, "missing")
+			})
+			Expect(failures).To(HaveLen(2))
+			Expect(failures[0]).To(ContainSubstring(`Attribute "attempt" of record 1 ("Retrying") is not numeric: first`))
+			Expect(failures[1]).To(ContainSubstring(`No log record has attribute "missing"`))
+		})
+	})
+
+	Describe("AssertLogAttrMatches", func() {
+		It("should match dynamic values by pattern", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Span started", "trace_id", "4bf92f3577b34da6a3ce929d0e0e4736", "port", 5432)
+
+			testlogger.AssertLogAttrMatches(buffer, "Span started", "trace_id", `^[0-9a-f]{32}$`)
+			testlogger.AssertLogAttrMatches(buffer, "Span started", "port", `^\d{4}$`)
+		})
+
+		It("should match grouped attributes in text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Request", slog.Group("http", "request_id", "req-0042"))
+
+			testlogger.AssertLogAttrMatches(buffer, "Request", "http.request_id", `^req-\d+$`)
+		})
+
+		It("should report the values found on mismatch", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Span started", "trace_id", "not-a-trace")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogAttrMatches(buffer, "Span started", "trace_id", `^[0-9a-f]{32}$`)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`found values: ["not-a-trace"]`))
+		})
+	})
+
+	Describe("AssertLoggedError", func() {
+		errConnRefused := errors.New("connection refused")
+
+		It("should match an error attached as an attribute", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Error("Fetch failed", "error", fmt.Errorf("fetch user 7: %w", errConnRefused))
+
+			testlogger.AssertLoggedError(buffer, errConnRefused)
+		})
+
+		It("should match errors in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Error("Fetch failed", "error", errConnRefused)
+
+			testlogger.AssertLoggedError(buffer, errConnRefused)
+		})
+
+		It("should fail when the error is only in the message", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Error("Fetch failed: " + errConnRefused.Error())
+			logger.Error("Fetch failed", "error", "timeout")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLoggedError(buffer, errConnRefused)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`found errors: ["timeout"]`))
+		})
+	})
+
+	Describe("AssertFirstLog and AssertLastLog", func() {
+		It("should match the first and last records", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Loading configuration")
+			logger.Info("Server started", "port", 8080)
+			logger.Info("Shutdown complete")
+
+			testlogger.AssertFirstLog(buffer, "Loading configuration")
+			testlogger.AssertLastLog(buffer, "Shutdown complete")
+		})
+
+		It("should report the actual record on mismatch", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Server started")
+			logger.Info("Loading configuration")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertFirstLog(buffer, "Loading configuration")
+				testlogger.AssertLastLog(buffer, "Server started")
+			})
+			Expect(failures).To(HaveLen(2))
+			Expect(failures[0]).To(ContainSubstring(`but it was: time=`))
+			Expect(failures[0]).To(ContainSubstring(`msg="Server started"`))
+			Expect(failures[1]).To(ContainSubstring(`msg="Loading configuration"`))
+		})
+
+		It("should fail clearly on an empty buffer", func() {
+			buffer := gbytes.NewBuffer()
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertFirstLog(buffer, "anything")
+				testlogger.AssertLastLog(buffer, "anything")
+			})
+			Expect(failures).To(HaveLen(2))
+			Expect(failures[0]).To(ContainSubstring("no logs were produced"))
+			Expect(failures[1]).To(ContainSubstring("no logs were produced"))
+		})
+	})
+
+	Describe("AssertResolvedAttr", func() {
+		It("should compare LogValuer attributes by their resolved form", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Login", "creds", credentials{User: "alice", Password: "hunter2"})
+
+			testlogger.AssertResolvedAttr(buffer, "Login", "creds", "alice:[REDACTED]")
+			Expect(string(buffer.Contents())).NotTo(ContainSubstring("hunter2"))
+		})
+
+		It("should resolve an expected value that is itself a LogValuer", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Login", "creds", credentials{User: "alice", Password: "hunter2"})
+
+			testlogger.AssertResolvedAttr(buffer, "Login", "creds", credentials{User: "alice"})
+		})
+
+		It("should fail when only the raw value would match", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Login", "creds", credentials{User: "alice", Password: "hunter2"})
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertResolvedAttr(buffer, "Login", "creds", "{alice hunter2}")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("AssertLogAttrValue", func() {
+		It("should match typed values in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "host", "localhost", "port", 5432, "tls", true, "load", 0.75)
+
+			testlogger.AssertLogAttrValue(buffer, "Connected", "host", slog.StringValue("localhost"))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "port", slog.IntValue(5432))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "port", slog.Uint64Value(5432))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "tls", slog.BoolValue(true))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "load", slog.Float64Value(0.75))
+		})
+
+		It("should infer kinds from text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "port", 5432, "tls", false, "timeout", 1500*time.Millisecond)
+
+			testlogger.AssertLogAttrValue(buffer, "Connected", "port", slog.IntValue(5432))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "tls", slog.BoolValue(false))
+			testlogger.AssertLogAttrValue(buffer, "Connected", "timeout", slog.DurationValue(1500*time.Millisecond))
+		})
+
+		It("should distinguish a string from a number in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "port", "5432")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogAttrValue(buffer, "Connected", "port", slog.IntValue(5432))
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("port=5432 (Int64)"))
+			Expect(failures[0]).To(ContainSubstring("5432 (String)"))
+		})
+	})
+
+	Describe("AssertLogJSONSchema", func() {
+		schema := map[string]reflect.Kind{
+			"trace_id":    reflect.String,
+			"attempt":     reflect.Int,
+			"http.status": reflect.Int,
+		}
+
+		It("should pass when every ERROR record matches the schema", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Starting")
+			logger.Error("Request failed", "trace_id", "abc123", "attempt", 2, slog.Group("http", "status", 503))
+
+			testlogger.AssertLogJSONSchema(buffer, schema)
+		})
+
+		It("should report missing keys and wrong kinds", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Error("Request failed", "trace_id", 42, "attempt", 1.5)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogJSONSchema(buffer, schema)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`"Request failed" record has "attempt" of kind float64, want int`))
+			Expect(failures[0]).To(ContainSubstring(`"Request failed" record is missing "http.status"`))
+			Expect(failures[0]).To(ContainSubstring(`"Request failed" record has "trace_id" of kind float64, want string`))
+		})
+	})
+
+	Describe("AssertLogAttr", func() {
+		It("should match attributes in text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "host", "localhost", "port", 5432, "tls", true)
+
+			testlogger.AssertLogAttr(buffer, "Connected", "host", "localhost")
+			testlogger.AssertLogAttr(buffer, "Connected", "port", 5432)
+			testlogger.AssertLogAttr(buffer, "Connected", "tls", true)
+		})
+
+		It("should match attributes in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "host", "localhost", "port", 5432, "tls", true)
+
+			testlogger.AssertLogAttr(buffer, "Connected", "host", "localhost")
+			testlogger.AssertLogAttr(buffer, "Connected", "port", 5432)
+			testlogger.AssertLogAttr(buffer, "Connected", "tls", true)
+		})
+
+		It("should match quoted text values and messages", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Query failed", "error", "connection refused")
+
+			testlogger.AssertLogAttr(buffer, "Query failed", "error", "connection refused")
+		})
+
+		It("should pass when any record with the message matches", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Retrying", "attempt", 1)
+			logger.Info("Retrying", "attempt", 2)
+
+			testlogger.AssertLogAttr(buffer, "Retrying", "attempt", 2)
+		})
+
+		It("should report mismatched values", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected", "port", 5432)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogAttr(buffer, "Connected", "port", 3306)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("port=3306"))
+			Expect(failures[0]).To(ContainSubstring("5432"))
+		})
+
+		It("should report a missing message", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Connected")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogAttr(buffer, "Disconnected", "port", 5432)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`No log record found with message "Disconnected"`))
+		})
+	})
+	Describe("AssertLogAttrCount", func() {
+		It("should count text attributes", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.With("service", "auth").Info("Login succeeded", "user", "alice")
+
+			testlogger.AssertLogAttrCount(buffer, "Login succeeded", 2)
+		})
+
+		It("should count a JSON group as one attribute", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Request", slog.Group("http", "method", "GET", "status", 200), "id", 7)
+
+			testlogger.AssertLogAttrCount(buffer, "Request", 2)
+		})
+
+		It("should report unexpected attributes", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Login succeeded", "user", "alice", "password", "hunter2")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogAttrCount(buffer, "Login succeeded", 1)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("to have 1 attributes but found 2: [password user]"))
+		})
+	})
+
+	Describe("AssertLogGroupAttr", func() {
+		It("should match grouped attributes in text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Request", slog.Group("http", "method", "GET", "status", 200))
+
+			testlogger.AssertLogGroupAttr(buffer, "Request", "http", "method", "GET")
+			testlogger.AssertLogGroupAttr(buffer, "Request", "http", "status", 200)
+		})
+
+		It("should match grouped attributes in JSON output", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Request", slog.Group("http", "method", "GET", "status", 200))
+
+			testlogger.AssertLogGroupAttr(buffer, "Request", "http", "method", "GET")
+			testlogger.AssertLogGroupAttr(buffer, "Request", "http", "status", 200)
+		})
+
+		It("should match nested groups", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.WithGroup("http").Info("Request", slog.Group("request", "path", "/api"))
+
+			testlogger.AssertLogGroupAttr(buffer, "Request", "http.request", "path", "/api")
+		})
+
+		It("should fail when the group lacks the attribute", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Request", slog.Group("http", "method", "GET"), "status", 200)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertLogGroupAttr(buffer, "Request", "http", "status", 200)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring("http.status=200"))
+		})
+	})
+	Describe("AssertNoLogAttr", func() {
+		It("should pass when the key only appears inside values", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Field ssn omitted", "note", "ssn=redacted")
+
+			testlogger.AssertNoLogAttr(buffer, "ssn")
+		})
+
+		It("should report records carrying the key, including inside groups", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			logger.Info("Registered", slog.Group("customer", "ssn", "123-45-6789"))
+			logger.Info("Verified", "ssn", "123-45-6789")
+			logger.Info("Welcome sent")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertNoLogAttr(buffer, "ssn")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`["Registered" "Verified"]`))
+		})
+
+		It("should find grouped keys in text output", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.WithGroup("customer").Info("Registered", "ssn", "123-45-6789")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertNoLogAttr(buffer, "ssn")
+			})
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	Describe("AssertAttrValuesExactly", func() {
+		It("should match values in any order across text and JSON records", func() {
+			textLogger, textBuffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+			jsonLogger, jsonBuffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+
+			for _, id := range []int{2, 0, 1} {
+				textLogger.Info("Worker done", "worker", id)
+				jsonLogger.Info("Worker done", "worker", id)
+			}
+			textLogger.Info("All workers done")
+
+			testlogger.AssertAttrValuesExactly(textBuffer, "worker", 0, 1, 2)
+			testlogger.AssertAttrValuesExactly(jsonBuffer, "worker", 0, 1, 2)
+		})
+
+		It("should fail on duplicated or missing values", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.Info("Worker done", "worker", 0)
+			logger.Info("Worker done", "worker", 0)
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertAttrValuesExactly(buffer, "worker", 0, 1)
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`Unexpected values for attribute "worker"`))
+		})
+	})
+
+	Describe("AssertBaseAttr", func() {
+		It("should pass when every record carries the attribute", func() {
+			logger, buffer := testlogger.WithCapturedJSONLogger(slog.LevelInfo)
+			derived := logger.With("service", "auth")
+
+			derived.Info("Login started")
+			derived.WithGroup("db").Error("Lookup failed", "table", "users")
+
+			testlogger.AssertBaseAttr(buffer, "service", "auth")
+		})
+
+		It("should name the records missing the attribute", func() {
+			logger, buffer := testlogger.WithCapturedLogger(slog.LevelInfo)
+
+			logger.With("service", "auth").Info("Login started")
+			logger.Info("Dropped base attributes")
+
+			failures := InterceptGomegaFailures(func() {
+				testlogger.AssertBaseAttr(buffer, "service", "auth")
+			})
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0]).To(ContainSubstring(`["Dropped base attributes"]`))
+		})
+	})
+})
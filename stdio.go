@@ -0,0 +1,73 @@
+package testlogger
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	. "github.com/onsi/gomega"
+)
+
+// CaptureStdout runs f with os.Stdout redirected to a pipe and returns
+// everything written to it, for asserting on logs from third-party code that
+// writes to stdout directly instead of accepting a *slog.Logger.
+//
+// os.Stdout is restored even if f panics. The pipe is drained concurrently,
+// so large output cannot fill the pipe buffer and block f.
+//
+// Usage:
+//
+//	output := CaptureStdout(func() {
+//	    legacy.Process()
+//	})
+//	Expect(output).To(ContainSubstring("processing complete"))
+func CaptureStdout(f func()) string {
+	return captureFile(&os.Stdout, f)
+}
+
+// CaptureStderr runs f with os.Stderr redirected to a pipe and returns
+// everything written to it, such as output from WithCapturedLoggerTee or
+// code that reports problems on stderr.
+//
+// os.Stderr is restored even if f panics. The pipe is drained concurrently,
+// so large output cannot fill the pipe buffer and block f.
+//
+// Usage:
+//
+//	output := CaptureStderr(func() {
+//	    legacy.Process()
+//	})
+//	Expect(output).To(ContainSubstring("retrying"))
+func CaptureStderr(f func()) string {
+	return captureFile(&os.Stderr, f)
+}
+
+// captureFile runs f with *target replaced by the write end of a pipe,
+// restoring it afterwards, and returns the captured output.
+func captureFile(target **os.File, f func()) string {
+	r, w, err := os.Pipe()
+	Expect(err).NotTo(HaveOccurred(), "Failed to create pipe for output capture")
+
+	// Drain concurrently so the writer never blocks on a full pipe. The
+	// channel is buffered so the goroutine can finish even if f panics.
+	output := make(chan string, 1)
+	go func() {
+		var buf bytes.Buffer
+		_, _ = io.Copy(&buf, r)
+		r.Close()
+		output <- buf.String()
+	}()
+
+	// Restore the original file and close the write end, even if f panics,
+	// so the drain sees EOF
+	func() {
+		original := *target
+		*target = w
+		defer func() {
+			*target = original
+			w.Close()
+		}()
+		f()
+	}()
+	return <-output
+}
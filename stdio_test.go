@@ -0,0 +1,68 @@
+package testlogger_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+var _ = Describe("Standard Stream Capture", func() {
+	Describe("CaptureStdout", func() {
+		It("should return output written to stdout", func() {
+			output := testlogger.CaptureStdout(func() {
+				fmt.Println("Third-party log line")
+			})
+
+			Expect(output).To(Equal("Third-party log line\n"))
+		})
+
+		It("should not block on output larger than the pipe buffer", func() {
+			line := strings.Repeat("x", 1023) + "\n"
+
+			output := testlogger.CaptureStdout(func() {
+				for range 1024 {
+					fmt.Print(line)
+				}
+			})
+
+			Expect(output).To(HaveLen(1024 * 1024))
+		})
+
+		It("should restore stdout when the function panics", func() {
+			original := os.Stdout
+
+			Expect(func() {
+				testlogger.CaptureStdout(func() {
+					panic("boom")
+				})
+			}).To(PanicWith("boom"))
+			Expect(os.Stdout).To(BeIdenticalTo(original))
+		})
+	})
+
+	Describe("CaptureStderr", func() {
+		It("should return output written to stderr", func() {
+			output := testlogger.CaptureStderr(func() {
+				fmt.Fprintln(os.Stderr, "Warning from legacy code")
+			})
+
+			Expect(output).To(Equal("Warning from legacy code\n"))
+		})
+
+		It("should restore stderr when the function panics", func() {
+			original := os.Stderr
+
+			Expect(func() {
+				testlogger.CaptureStderr(func() {
+					panic("boom")
+				})
+			}).To(PanicWith("boom"))
+			Expect(os.Stderr).To(BeIdenticalTo(original))
+		})
+	})
+})
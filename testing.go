@@ -0,0 +1,55 @@
+package testlogger
+
+import (
+	"log/slog"
+	"regexp"
+	"testing"
+)
+
+// ExpectErrorLogT is like ExpectErrorLog but reports through the standard
+// testing package instead of Gomega, for suites using plain or table-driven
+// tests.
+//
+// Each pattern is compiled as a regular expression and, as with
+// ExpectErrorLog, patterns must match in the order given. A missing pattern
+// fails the test via t.Errorf, and unexpected logs (lines matching no
+// pattern) are written to the test log via t.Log rather than GinkgoWriter.
+//
+// Usage:
+//
+//	func TestCallAPI(t *testing.T) {
+//	    testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {
+//	        client := NewClient(logger)
+//	        if err := client.CallAPI(); err == nil {
+//	            t.Error("expected an error")
+//	        }
+//	    }, "rate limit exceeded", "status=429")
+//	}
+func ExpectErrorLogT(t testing.TB, testFunc func(*slog.Logger), expectedPatterns ...string) {
+	t.Helper()
+
+	patterns := make([]*regexp.Regexp, 0, len(expectedPatterns))
+	for _, pattern := range expectedPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			t.Errorf("Invalid log pattern %q: %v", pattern, err)
+			return
+		}
+		patterns = append(patterns, re)
+	}
+
+	output := captureLogs(textHandler, &slog.HandlerOptions{Level: errorCaptureLevel()}, testFunc)
+	cursor := 0
+	for _, re := range patterns {
+		loc := re.FindStringIndex(output[cursor:])
+		if loc == nil {
+			t.Errorf("Expected error log pattern not found: %s", re)
+			continue
+		}
+		cursor += loc[1]
+	}
+
+	for _, line := range unexpectedLogs(output, patterns) {
+		t.Log(redactRecord(line))
+	}
+}
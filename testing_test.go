@@ -0,0 +1,82 @@
+package testlogger_test
+
+import (
+	"fmt"
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testlogger "github.com/JohnPlummer/go-test-logger"
+)
+
+// recordingT captures failures and log output reported through testing.TB.
+type recordingT struct {
+	testing.TB
+	errors []string
+	logs   []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) Log(args ...any) {
+	t.logs = append(t.logs, fmt.Sprint(args...))
+}
+
+func TestExpectErrorLogT(t *testing.T) {
+	testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {
+		logger.Error("API call failed", "status", 429)
+	}, "API call failed", `status=\d+`)
+}
+
+var _ = Describe("Testing Package Integration", func() {
+	Describe("ExpectErrorLogT", func() {
+		It("should report missing patterns through Errorf", func() {
+			t := &recordingT{}
+
+			testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {
+				logger.Error("API call failed")
+			}, "API call failed", "rate limit exceeded")
+
+			Expect(t.errors).To(ConsistOf("Expected error log pattern not found: rate limit exceeded"))
+		})
+
+		It("should require patterns to match in order", func() {
+			t := &recordingT{}
+
+			testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {
+				logger.Error("second")
+				logger.Error("first")
+			}, "first", "second")
+
+			Expect(t.errors).To(ConsistOf("Expected error log pattern not found: second"))
+		})
+
+		It("should write unexpected logs to the test log", func() {
+			t := &recordingT{}
+
+			testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {
+				logger.Error("Expected failure")
+				logger.Error("Unexpected failure")
+			}, "Expected failure")
+
+			Expect(t.errors).To(BeEmpty())
+			Expect(t.logs).To(HaveLen(1))
+			Expect(t.logs[0]).To(ContainSubstring("Unexpected failure"))
+		})
+
+		It("should report invalid patterns", func() {
+			t := &recordingT{}
+
+			testlogger.ExpectErrorLogT(t, func(logger *slog.Logger) {}, "[unclosed")
+
+			Expect(t.errors).To(HaveLen(1))
+			Expect(t.errors[0]).To(ContainSubstring("Invalid log pattern"))
+		})
+	})
+})